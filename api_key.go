@@ -3,7 +3,6 @@ package contentful
 import (
 	"bytes"
 	"encoding/json"
-	"fmt"
 	"strconv"
 )
 
@@ -54,7 +53,7 @@ func (apiKey *APIKey) GetVersion() int {
 
 // List returns all api keys collection
 func (service *APIKeyService) List(spaceID string) *Collection {
-	path := fmt.Sprintf("/spaces/%s/api_keys", spaceID)
+	path := spacePath(spaceID, "/api_keys")
 	method := "GET"
 
 	req, err := service.c.newRequest(method, path, nil, nil)
@@ -71,7 +70,7 @@ func (service *APIKeyService) List(spaceID string) *Collection {
 
 // Get returns a single api key entity
 func (service *APIKeyService) Get(spaceID, apiKeyID string) (*APIKey, error) {
-	path := fmt.Sprintf("/spaces/%s/api_keys/%s", spaceID, apiKeyID)
+	path := spacePath(spaceID, "/api_keys/%s", apiKeyID)
 	method := "GET"
 
 	req, err := service.c.newRequest(method, path, nil, nil)
@@ -94,14 +93,14 @@ func (service *APIKeyService) Upsert(spaceID string, apiKey *APIKey) error {
 		return err
 	}
 
-	var path string
+	var path apiPath
 	var method string
 
 	if apiKey.Sys != nil && apiKey.Sys.CreatedAt != "" {
-		path = fmt.Sprintf("/spaces/%s/api_keys/%s", spaceID, apiKey.Sys.ID)
+		path = spacePath(spaceID, "/api_keys/%s", apiKey.Sys.ID)
 		method = "PUT"
 	} else {
-		path = fmt.Sprintf("/spaces/%s/api_keys", spaceID)
+		path = spacePath(spaceID, "/api_keys")
 		method = "POST"
 	}
 
@@ -110,14 +109,14 @@ func (service *APIKeyService) Upsert(spaceID string, apiKey *APIKey) error {
 		return err
 	}
 
-	req.Header.Set("X-Contentful-Version", strconv.Itoa(apiKey.GetVersion()))
+	setVersionHeader(req, apiKey.Sys)
 
 	return service.c.do(req, apiKey)
 }
 
 // Delete deletes a sinlge api key entity
 func (service *APIKeyService) Delete(spaceID string, apiKey *APIKey) error {
-	path := fmt.Sprintf("/spaces/%s/api_keys/%s", spaceID, apiKey.Sys.ID)
+	path := spacePath(spaceID, "/api_keys/%s", apiKey.Sys.ID)
 	method := "DELETE"
 
 	req, err := service.c.newRequest(method, path, nil, nil)
@@ -0,0 +1,107 @@
+package contentful
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// AppDefinitionsService service
+type AppDefinitionsService service
+
+// AppDefinition model, an app of an organization which can be installed
+// into space environments
+type AppDefinition struct {
+	Sys       *Sys           `json:"sys,omitempty"`
+	Name      string         `json:"name,omitempty"`
+	Src       string         `json:"src,omitempty"`
+	Locations []*AppLocation `json:"locations,omitempty"`
+}
+
+// AppLocation model, a place in the web app the app is rendered at, e.g.
+// "entry-field" together with the field types it can edit
+type AppLocation struct {
+	Location   string                `json:"location"`
+	FieldTypes []*ExtensionFieldType `json:"fieldTypes,omitempty"`
+}
+
+// List returns an app definitions collection
+func (service *AppDefinitionsService) List(organizationID string) *Collection {
+	path := pathf("/organizations/%s/app_definitions", organizationID)
+	method := "GET"
+
+	req, err := service.c.newRequest(method, path, nil, nil)
+	if err != nil {
+		return &Collection{}
+	}
+
+	col := NewCollection(&CollectionOptions{})
+	col.c = service.c
+	col.req = req
+
+	return col
+}
+
+// Get returns a single app definition
+func (service *AppDefinitionsService) Get(organizationID, appDefinitionID string) (*AppDefinition, error) {
+	path := pathf("/organizations/%s/app_definitions/%s", organizationID, appDefinitionID)
+	method := "GET"
+
+	req, err := service.c.newRequest(method, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var definition AppDefinition
+	if err := service.c.do(req, &definition); err != nil {
+		return nil, err
+	}
+
+	return &definition, nil
+}
+
+// Upsert updates or creates a new app definition
+func (service *AppDefinitionsService) Upsert(organizationID string, definition *AppDefinition) error {
+	bytesArray, err := json.Marshal(&struct {
+		Name      string         `json:"name"`
+		Src       string         `json:"src,omitempty"`
+		Locations []*AppLocation `json:"locations,omitempty"`
+	}{
+		Name:      definition.Name,
+		Src:       definition.Src,
+		Locations: definition.Locations,
+	})
+	if err != nil {
+		return err
+	}
+
+	var path apiPath
+	var method string
+
+	if definition.Sys != nil && definition.Sys.ID != "" {
+		path = pathf("/organizations/%s/app_definitions/%s", organizationID, definition.Sys.ID)
+		method = "PUT"
+	} else {
+		path = pathf("/organizations/%s/app_definitions", organizationID)
+		method = "POST"
+	}
+
+	req, err := service.c.newRequest(method, path, nil, bytes.NewReader(bytesArray))
+	if err != nil {
+		return err
+	}
+
+	return service.c.do(req, definition)
+}
+
+// Delete the app definition
+func (service *AppDefinitionsService) Delete(organizationID string, definition *AppDefinition) error {
+	path := pathf("/organizations/%s/app_definitions/%s", organizationID, definition.Sys.ID)
+	method := "DELETE"
+
+	req, err := service.c.newRequest(method, path, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return service.c.do(req, nil)
+}
@@ -0,0 +1,50 @@
+package contentful
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppDefinitionsServiceUpsert(t *testing.T) {
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("POST", r.Method)
+		assert.Equal("/organizations/"+organizationID+"/app_definitions", r.URL.Path)
+		checkHeaders(r, assert)
+
+		var payload map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assert.Nil(err)
+		assert.Equal("Release notifier", payload["name"])
+		assert.Equal([]interface{}{
+			map[string]interface{}{"location": "app-config"},
+		}, payload["locations"])
+
+		w.WriteHeader(201)
+		fmt.Fprintln(w, `{"sys": {"type": "AppDefinition", "id": "app-id"}, "name": "Release notifier", "src": "https://example.com/app", "locations": [{"location": "app-config"}]}`)
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	definition := &AppDefinition{
+		Name:      "Release notifier",
+		Src:       "https://example.com/app",
+		Locations: []*AppLocation{&AppLocation{Location: "app-config"}},
+	}
+
+	err := cma.AppDefinitions.Upsert(organizationID, definition)
+	assert.Nil(err)
+	assert.Equal("app-id", definition.Sys.ID)
+}
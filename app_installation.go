@@ -0,0 +1,90 @@
+package contentful
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+)
+
+// AppInstallationsService service
+type AppInstallationsService service
+
+// AppInstallation model, an app definition installed into a space
+// environment
+type AppInstallation struct {
+	Sys        *Sys                   `json:"sys,omitempty"`
+	Parameters map[string]interface{} `json:"parameters,omitempty"`
+}
+
+// List returns an app installations collection
+func (service *AppInstallationsService) List(spaceID string) *Collection {
+	path := service.c.envPath(spaceID, "/app_installations")
+	method := "GET"
+
+	req, err := service.c.newRequest(method, path, nil, nil)
+	if err != nil {
+		return &Collection{}
+	}
+
+	col := NewCollection(&CollectionOptions{})
+	col.c = service.c
+	col.req = req
+
+	return col
+}
+
+// Get returns the installation of the given app definition
+func (service *AppInstallationsService) Get(ctx context.Context, spaceID, appDefinitionID string) (*AppInstallation, error) {
+	path := service.c.envPath(spaceID, "/app_installations/%s", appDefinitionID)
+	method := "GET"
+
+	req, err := service.c.newRequest(method, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var installation AppInstallation
+	if err := service.c.do(withContext(req, ctx), &installation); err != nil {
+		return nil, err
+	}
+
+	return &installation, nil
+}
+
+// Upsert installs the given app definition, or updates the parameters of an
+// existing installation
+func (service *AppInstallationsService) Upsert(ctx context.Context, spaceID, appDefinitionID string, parameters map[string]interface{}) error {
+	if parameters == nil {
+		parameters = map[string]interface{}{}
+	}
+
+	bytesArray, err := json.Marshal(&AppInstallation{
+		Parameters: parameters,
+	})
+	if err != nil {
+		return err
+	}
+
+	path := service.c.envPath(spaceID, "/app_installations/%s", appDefinitionID)
+	method := "PUT"
+
+	req, err := service.c.newRequest(method, path, nil, bytes.NewReader(bytesArray))
+	if err != nil {
+		return err
+	}
+
+	return service.c.do(withContext(req, ctx), nil)
+}
+
+// Delete uninstalls the given app definition
+func (service *AppInstallationsService) Delete(spaceID, appDefinitionID string) error {
+	path := service.c.envPath(spaceID, "/app_installations/%s", appDefinitionID)
+	method := "DELETE"
+
+	req, err := service.c.newRequest(method, path, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return service.c.do(req, nil)
+}
@@ -0,0 +1,105 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const appInstallationJSON = `{
+	"sys": {
+		"type": "AppInstallation",
+		"appDefinition": {"sys": {"type": "Link", "linkType": "AppDefinition", "id": "app-id"}},
+		"environment": {"sys": {"type": "Link", "linkType": "Environment", "id": "master"}}
+	},
+	"parameters": {"channel": "#releases", "notify": true}
+}`
+
+func TestAppInstallationsServiceGet(t *testing.T) {
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("GET", r.Method)
+		assert.Equal("/spaces/"+spaceID+"/environments/staging/app_installations/app-id", r.URL.Path)
+		checkHeaders(r, assert)
+
+		w.WriteHeader(200)
+		fmt.Fprintln(w, appInstallationJSON)
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	installation, err := cma.AppInstallations.Get(WithRequestEnvironment(context.Background(), "staging"), spaceID, "app-id")
+	assert.Nil(err)
+	assert.Equal("#releases", installation.Parameters["channel"])
+}
+
+func TestAppInstallationsServiceUpsert(t *testing.T) {
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("PUT", r.Method)
+		assert.Equal("/spaces/"+spaceID+"/environments/staging/app_installations/app-id", r.URL.Path)
+		checkHeaders(r, assert)
+
+		var payload map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assert.Nil(err)
+		assert.Equal(map[string]interface{}{
+			"parameters": map[string]interface{}{
+				"channel": "#releases",
+				"notify":  true,
+			},
+		}, payload)
+
+		w.WriteHeader(200)
+		fmt.Fprintln(w, appInstallationJSON)
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken, WithEnvironment("staging"))
+	cma.BaseURL = server.URL
+
+	err := cma.AppInstallations.Upsert(context.Background(), spaceID, "app-id", map[string]interface{}{
+		"channel": "#releases",
+		"notify":  true,
+	})
+	assert.Nil(err)
+}
+
+func TestAppInstallationsServiceDelete(t *testing.T) {
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("DELETE", r.Method)
+		assert.Equal("/spaces/"+spaceID+"/environments/master/app_installations/app-id", r.URL.Path)
+		checkHeaders(r, assert)
+
+		w.WriteHeader(204)
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	assert.Nil(cma.AppInstallations.Delete(spaceID, "app-id"))
+}
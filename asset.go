@@ -2,11 +2,19 @@ package contentful
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
 	"strconv"
+	"time"
 )
 
+// uploadHost is the host of the upload api, files are not delivered from it
+const uploadHost = "upload.contentful.com"
+
 // AssetsService service
 type AssetsService service
 
@@ -16,6 +24,7 @@ type File struct {
 	ContentType string      `json:"contentType,omitempty"`
 	URL         string      `json:"url,omitempty"`
 	UploadURL   string      `json:"upload,omitempty"`
+	UploadFrom  *Link       `json:"uploadFrom,omitempty"`
 	Detail      *FileDetail `json:"details,omitempty"`
 }
 
@@ -38,11 +47,19 @@ type FileFields struct {
 	File        *File  `json:"file,omitempty"`
 }
 
-// Asset model
+// Asset model. Fields holds the fields of the asset's locale, see Locale.
+// Assets read with the fields of several locales keep all of them in
+// Localized, and write them back on marshaling.
 type Asset struct {
-	locale string
-	Sys    *Sys        `json:"sys"`
-	Fields *FileFields `json:"fields"`
+	locale    string
+	Sys       *Sys                   `json:"sys"`
+	Fields    *FileFields            `json:"fields"`
+	Localized map[string]*FileFields `json:"-"`
+}
+
+// Locale returns the locale of the asset's Fields
+func (asset *Asset) Locale() string {
+	return asset.locale
 }
 
 // MarshalJSON for custom json marshaling
@@ -58,74 +75,174 @@ func (asset *Asset) MarshalJSON() ([]byte, error) {
 
 	payload["sys"] = asset.Sys
 	fields := payload["fields"].(map[string]interface{})
-
-	// title
 	title := fields["title"].(map[string]string)
-	title[asset.locale] = asset.Fields.Title
-
-	// description
 	description := fields["description"].(map[string]string)
-	description[asset.locale] = asset.Fields.Description
-
-	// file
 	file := fields["file"].(map[string]interface{})
-	file[asset.locale] = asset.Fields.File
+
+	// the other locales are written as they were read
+	for locale, localized := range asset.Localized {
+		if locale == asset.locale || localized == nil {
+			continue
+		}
+
+		if localized.Title != "" {
+			title[locale] = localized.Title
+		}
+		if localized.Description != "" {
+			description[locale] = localized.Description
+		}
+		if localized.File != nil {
+			file[locale] = localized.File
+		}
+	}
+
+	if asset.Fields != nil {
+		title[asset.locale] = asset.Fields.Title
+		description[asset.locale] = asset.Fields.Description
+		file[asset.locale] = asset.Fields.File
+	}
 
 	return json.Marshal(payload)
 }
 
-// UnmarshalJSON for custom json unmarshaling
+// UnmarshalJSON for custom json unmarshaling. Fields given as locale maps are
+// read into Localized for every locale, Fields holds those of the asset's
+// locale, or of the first locale found (in alphabetical order) when the asset
+// has no locale set yet.
 func (asset *Asset) UnmarshalJSON(data []byte) error {
-	type Alias *Asset
-
-	var payload map[string]interface{}
+	var payload struct {
+		Sys    *Sys                       `json:"sys"`
+		Fields map[string]json.RawMessage `json:"fields"`
+	}
 	if err := json.Unmarshal(data, &payload); err != nil {
 		return err
 	}
 
-	fileName := payload["fields"].(map[string]interface{})["file"].(map[string]interface{})["fileName"]
-	localized := true
+	asset.Sys = payload.Sys
+	asset.Fields = &FileFields{}
 
-	if fileName == nil {
-		localized = false
+	var file map[string]json.RawMessage
+	if raw, ok := payload.Fields["file"]; ok {
+		if err := json.Unmarshal(raw, &file); err != nil {
+			return err
+		}
 	}
 
-	if localized == false {
-		asset.Sys = &Sys{}
-		b, _ := json.Marshal(payload["sys"])
-		if err := json.Unmarshal(b, asset.Sys); err != nil {
+	_, hasFileName := file["fileName"]
+	_, hasUpload := file["upload"]
+
+	// fields of a single locale asset are not wrapped into locale maps
+	if hasFileName || hasUpload || !isLocaleMaps(payload.Fields) {
+		byteArray, err := json.Marshal(payload.Fields)
+		if err != nil {
 			return err
 		}
 
-		title := payload["fields"].(map[string]interface{})["title"]
-		if title != nil {
-			title = title.(map[string]interface{})[asset.locale]
-		}
+		return json.Unmarshal(byteArray, asset.Fields)
+	}
 
-		description := payload["fields"].(map[string]interface{})["description"]
-		if description != nil {
-			description = description.(map[string]interface{})[asset.locale]
+	// the fields of each locale, by locale
+	byLocale := map[string]map[string]json.RawMessage{}
+
+	for key, raw := range payload.Fields {
+		var values map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &values); err != nil {
+			return err
 		}
 
-		asset.Fields = &FileFields{
-			Title:       title.(string),
-			Description: description.(string),
-			File:        &File{},
+		for locale, value := range values {
+			if byLocale[locale] == nil {
+				byLocale[locale] = map[string]json.RawMessage{}
+			}
+			byLocale[locale][key] = value
 		}
+	}
 
-		file := payload["fields"].(map[string]interface{})["file"].(map[string]interface{})[asset.locale]
-		if err := json.Unmarshal([]byte(file.(string)), asset.Fields.File); err != nil {
+	asset.Localized = map[string]*FileFields{}
+	for locale, fields := range byLocale {
+		byteArray, err := json.Marshal(fields)
+		if err != nil {
 			return err
 		}
-	} else {
-		if err := json.Unmarshal(data, Alias(asset)); err != nil {
+
+		localized := &FileFields{}
+		if err := json.Unmarshal(byteArray, localized); err != nil {
 			return err
 		}
+
+		asset.Localized[locale] = localized
+	}
+
+	if asset.locale == "" {
+		asset.locale = firstLocale(asset.Localized)
+	}
+
+	if localized, ok := asset.Localized[asset.locale]; ok {
+		asset.Fields = localized
 	}
 
 	return nil
 }
 
+// isLocaleMaps reports whether all the field values are json objects
+func isLocaleMaps(fields map[string]json.RawMessage) bool {
+	for _, raw := range fields {
+		var values map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &values); err != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+func firstLocale(values map[string]*FileFields) string {
+	locales := []string{}
+	for locale := range values {
+		locales = append(locales, locale)
+	}
+
+	sort.Strings(locales)
+
+	if len(locales) == 0 {
+		return ""
+	}
+
+	return locales[0]
+}
+
+// SecureFileURL returns the https url the asset's file of the given locale is
+// delivered from. Files which are uploaded but not processed yet have no
+// delivery url, only their upload url.
+func (asset *Asset) SecureFileURL(locale string) (string, error) {
+	file, err := asset.file(locale)
+	if err != nil {
+		return "", err
+	}
+
+	if file.URL == "" {
+		if file.UploadURL != "" {
+			return "", fmt.Errorf("asset file uploaded from %s is not processed yet", file.UploadURL)
+		}
+
+		return "", fmt.Errorf("asset file is not processed yet")
+	}
+
+	u, err := url.Parse(file.URL)
+	if err != nil {
+		return "", err
+	}
+
+	if u.Host == uploadHost {
+		return "", fmt.Errorf("asset file url %s is an upload url, process the asset first", file.URL)
+	}
+
+	// delivery urls are protocol relative, e.g. //images.ctfassets.net/...
+	u.Scheme = "https"
+
+	return u.String(), nil
+}
+
 // GetVersion returns entity version
 func (asset *Asset) GetVersion() int {
 	version := 1
@@ -136,9 +253,14 @@ func (asset *Asset) GetVersion() int {
 	return version
 }
 
+// Status returns the publishing state of the asset
+func (asset *Asset) Status() EntryStatus {
+	return asset.Sys.status()
+}
+
 // List returns asset collection
 func (service *AssetsService) List(spaceID string) *Collection {
-	path := fmt.Sprintf("/spaces/%s/assets", spaceID)
+	path := service.c.envPath(spaceID, "/assets")
 	method := "GET"
 
 	req, err := service.c.newRequest(method, path, nil, nil)
@@ -155,7 +277,16 @@ func (service *AssetsService) List(spaceID string) *Collection {
 
 // Get returns a single asset entity
 func (service *AssetsService) Get(spaceID, assetID string) (*Asset, error) {
-	path := fmt.Sprintf("/spaces/%s/assets/%s", spaceID, assetID)
+	return service.get(context.Background(), spaceID, assetID)
+}
+
+// GetContext returns a single asset like Get, the request is made with ctx
+func (service *AssetsService) GetContext(ctx context.Context, spaceID, assetID string) (*Asset, error) {
+	return service.get(ctx, spaceID, assetID)
+}
+
+func (service *AssetsService) get(ctx context.Context, spaceID, assetID string) (*Asset, error) {
+	path := service.c.envPath(spaceID, "/assets/%s", assetID)
 	method := "GET"
 
 	req, err := service.c.newRequest(method, path, nil, nil)
@@ -164,7 +295,7 @@ func (service *AssetsService) Get(spaceID, assetID string) (*Asset, error) {
 	}
 
 	var asset Asset
-	if err := service.c.do(req, &asset); err != nil {
+	if err := service.c.do(withContext(req, ctx), &asset); err != nil {
 		return nil, err
 	}
 
@@ -173,19 +304,29 @@ func (service *AssetsService) Get(spaceID, assetID string) (*Asset, error) {
 
 // Upsert updates or creates a new asset entity
 func (service *AssetsService) Upsert(spaceID string, asset *Asset) error {
+	return service.upsert(context.Background(), spaceID, asset)
+}
+
+// UpsertContext updates or creates the asset like Upsert, the request is made
+// with ctx
+func (service *AssetsService) UpsertContext(ctx context.Context, spaceID string, asset *Asset) error {
+	return service.upsert(ctx, spaceID, asset)
+}
+
+func (service *AssetsService) upsert(ctx context.Context, spaceID string, asset *Asset) error {
 	bytesArray, err := json.Marshal(asset)
 	if err != nil {
 		return err
 	}
 
-	var path string
+	var path apiPath
 	var method string
 
 	if asset.Sys.CreatedAt != "" {
-		path = fmt.Sprintf("/spaces/%s/assets/%s", spaceID, asset.Sys.ID)
+		path = service.c.envPath(spaceID, "/assets/%s", asset.Sys.ID)
 		method = "PUT"
 	} else {
-		path = fmt.Sprintf("/spaces/%s/assets", spaceID)
+		path = service.c.envPath(spaceID, "/assets")
 		method = "POST"
 	}
 
@@ -194,14 +335,61 @@ func (service *AssetsService) Upsert(spaceID string, asset *Asset) error {
 		return err
 	}
 
-	req.Header.Set("X-Contentful-Version", strconv.Itoa(asset.GetVersion()))
+	setVersionHeader(req, asset.Sys)
 
-	return service.c.do(req, asset)
+	return service.c.do(withContext(req, ctx), asset)
+}
+
+// CreateIdempotent creates an asset with the id derived from the given
+// idempotency key, see IdempotentID. When the asset exists already, e.g.
+// because the create is replayed after its response got lost, the existing
+// asset is fetched into `asset` instead of creating a duplicate.
+func (service *AssetsService) CreateIdempotent(ctx context.Context, spaceID, key string, asset *Asset) error {
+	if asset.Sys == nil {
+		asset.Sys = &Sys{}
+	}
+	asset.Sys.ID = IdempotentID(key)
+
+	bytesArray, err := json.Marshal(asset)
+	if err != nil {
+		return err
+	}
+
+	path := service.c.envPath(spaceID, "/assets/%s", asset.Sys.ID)
+	method := "PUT"
+
+	req, err := service.c.newRequest(method, path, nil, bytes.NewReader(bytesArray))
+	if err != nil {
+		return err
+	}
+
+	err = service.c.do(withContext(req, ctx), asset)
+	if _, ok := err.(VersionMismatchError); !ok {
+		return err
+	}
+
+	existing, err := service.get(ctx, spaceID, asset.Sys.ID)
+	if err != nil {
+		return err
+	}
+
+	*asset = *existing
+
+	return nil
 }
 
 // Delete sends delete request
 func (service *AssetsService) Delete(spaceID string, asset *Asset) error {
-	path := fmt.Sprintf("/spaces/%s/assets/%s", spaceID, asset.Sys.ID)
+	return service.delete(context.Background(), spaceID, asset)
+}
+
+// DeleteContext deletes the asset like Delete, the request is made with ctx
+func (service *AssetsService) DeleteContext(ctx context.Context, spaceID string, asset *Asset) error {
+	return service.delete(ctx, spaceID, asset)
+}
+
+func (service *AssetsService) delete(ctx context.Context, spaceID string, asset *Asset) error {
+	path := service.c.envPath(spaceID, "/assets/%s", asset.Sys.ID)
 	method := "DELETE"
 
 	req, err := service.c.newRequest(method, path, nil, nil)
@@ -212,12 +400,16 @@ func (service *AssetsService) Delete(spaceID string, asset *Asset) error {
 	version := strconv.Itoa(asset.Sys.Version)
 	req.Header.Set("X-Contentful-Version", version)
 
-	return service.c.do(req, nil)
+	return service.c.do(withContext(req, ctx), nil)
 }
 
 // Process the asset
 func (service *AssetsService) Process(spaceID string, asset *Asset) error {
-	path := fmt.Sprintf("/spaces/%s/assets/%s/files/%s/process", spaceID, asset.Sys.ID, asset.locale)
+	return service.process(context.Background(), spaceID, asset)
+}
+
+func (service *AssetsService) process(ctx context.Context, spaceID string, asset *Asset) error {
+	path := service.c.envPath(spaceID, "/assets/%s/files/%s/process", asset.Sys.ID, asset.locale)
 	method := "PUT"
 
 	req, err := service.c.newRequest(method, path, nil, nil)
@@ -228,12 +420,61 @@ func (service *AssetsService) Process(spaceID string, asset *Asset) error {
 	version := strconv.Itoa(asset.Sys.Version)
 	req.Header.Set("X-Contentful-Version", version)
 
-	return service.c.do(req, nil)
+	return service.c.do(withContext(req, ctx), nil)
+}
+
+// WaitForProcessing polls the asset every interval until the file of the
+// given locale has a delivery url, i.e. it is processed, and returns the
+// processed asset. When ctx is done first, an error wrapping the context's
+// error is returned.
+func (service *AssetsService) WaitForProcessing(ctx context.Context, spaceID, assetID, locale string, interval time.Duration) (*Asset, error) {
+	path := service.c.envPath(spaceID, "/assets/%s", assetID)
+	timeout := func() error {
+		return fmt.Errorf("timed out waiting for the %s file of asset %s to be processed: %w", locale, assetID, ctx.Err())
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		req, err := service.c.newRequest(http.MethodGet, path, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		asset := Asset{locale: locale}
+		if err := service.c.do(withContext(req, ctx), &asset); err != nil {
+			if ctx.Err() != nil {
+				return nil, timeout()
+			}
+
+			return nil, err
+		}
+
+		if asset.Fields != nil && asset.Fields.File != nil && asset.Fields.File.URL != "" {
+			return &asset, nil
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil, timeout()
+		}
+	}
 }
 
 // Publish published the asset
 func (service *AssetsService) Publish(spaceID string, asset *Asset) error {
-	path := fmt.Sprintf("/spaces/%s/assets/%s/published", spaceID, asset.Sys.ID)
+	return service.publish(context.Background(), spaceID, asset)
+}
+
+// PublishContext publishes the asset like Publish, the request is made with ctx
+func (service *AssetsService) PublishContext(ctx context.Context, spaceID string, asset *Asset) error {
+	return service.publish(ctx, spaceID, asset)
+}
+
+func (service *AssetsService) publish(ctx context.Context, spaceID string, asset *Asset) error {
+	path := service.c.envPath(spaceID, "/assets/%s/published", asset.Sys.ID)
 	method := "PUT"
 
 	req, err := service.c.newRequest(method, path, nil, nil)
@@ -244,5 +485,71 @@ func (service *AssetsService) Publish(spaceID string, asset *Asset) error {
 	version := strconv.Itoa(asset.Sys.Version)
 	req.Header.Set("X-Contentful-Version", version)
 
-	return service.c.do(req, asset)
+	return service.c.do(withContext(req, ctx), asset)
+}
+
+// Unpublish the asset
+func (service *AssetsService) Unpublish(spaceID string, asset *Asset) error {
+	return service.unpublish(context.Background(), spaceID, asset)
+}
+
+// UnpublishContext unpublishes the asset like Unpublish, the request is made with
+// ctx
+func (service *AssetsService) UnpublishContext(ctx context.Context, spaceID string, asset *Asset) error {
+	return service.unpublish(ctx, spaceID, asset)
+}
+
+func (service *AssetsService) unpublish(ctx context.Context, spaceID string, asset *Asset) error {
+	path := service.c.envPath(spaceID, "/assets/%s/published", asset.Sys.ID)
+	method := "DELETE"
+
+	req, err := service.c.newRequest(method, path, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	version := strconv.Itoa(asset.Sys.Version)
+	req.Header.Set("X-Contentful-Version", version)
+
+	return service.c.do(withContext(req, ctx), asset)
+}
+
+// ForceDelete deletes the asset, unpublishing it first when it is published.
+// The version of the unpublished asset is sent with the delete, so the
+// delete fails when the asset is changed in between.
+func (service *AssetsService) ForceDelete(ctx context.Context, spaceID string, asset *Asset) error {
+	if asset.Sys == nil || asset.Sys.ID == "" {
+		return fmt.Errorf("deleting an asset requires an asset id")
+	}
+
+	if asset.Sys.isPublished() {
+		path := service.c.envPath(spaceID, "/assets/%s/published", asset.Sys.ID)
+
+		req, err := service.c.newRequest(http.MethodDelete, path, nil, nil)
+		if err != nil {
+			return err
+		}
+
+		req.Header.Set("X-Contentful-Version", strconv.Itoa(asset.Sys.Version))
+
+		unpublished := Asset{locale: asset.locale}
+		if err := service.c.do(withContext(req, ctx), &unpublished); err != nil {
+			return err
+		}
+
+		if unpublished.Sys != nil {
+			asset.Sys = unpublished.Sys
+		}
+	}
+
+	path := service.c.envPath(spaceID, "/assets/%s", asset.Sys.ID)
+
+	req, err := service.c.newRequest(http.MethodDelete, path, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("X-Contentful-Version", strconv.Itoa(asset.Sys.Version))
+
+	return service.c.do(withContext(req, ctx), nil)
 }
@@ -0,0 +1,217 @@
+package contentful
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// maxImageSize is the largest width and height the images api resizes to
+const maxImageSize = 4000
+
+var imageFits = map[string]bool{
+	"pad":   true,
+	"fill":  true,
+	"scale": true,
+	"crop":  true,
+	"thumb": true,
+}
+
+var imageFocusAreas = map[string]bool{
+	"center":       true,
+	"top":          true,
+	"right":        true,
+	"left":         true,
+	"bottom":       true,
+	"top_right":    true,
+	"top_left":     true,
+	"bottom_right": true,
+	"bottom_left":  true,
+	"face":         true,
+	"faces":        true,
+}
+
+var imageFormats = map[string]bool{
+	"jpg":  true,
+	"png":  true,
+	"webp": true,
+	"gif":  true,
+	"avif": true,
+}
+
+var imageBackground = regexp.MustCompile(`^rgb:[0-9a-fA-F]{6}$`)
+
+// ImageOptions model, the transformations of the images api, zero values are
+// left out
+type ImageOptions struct {
+	// Width and Height in pixels, up to 4000
+	Width  int
+	Height int
+
+	// Fit is one of pad, fill, scale, crop or thumb
+	Fit string
+
+	// Focus is the area kept when resizing with fit pad, fill, crop or
+	// thumb, e.g. center, top_left or face
+	Focus string
+
+	// Format is one of jpg, png, webp, gif or avif
+	Format string
+
+	// Progressive converts to a progressive jpg
+	Progressive bool
+
+	// Quality in percent, from 1 to 100
+	Quality int
+
+	// Background fills the padding with fit pad, e.g. "rgb:ff0000"
+	Background string
+}
+
+// validate returns an error for illegal options and combinations
+func (opts ImageOptions) validate() error {
+	if opts.Width < 0 || opts.Width > maxImageSize {
+		return fmt.Errorf("width must be between 0 and %d, got %d", maxImageSize, opts.Width)
+	}
+
+	if opts.Height < 0 || opts.Height > maxImageSize {
+		return fmt.Errorf("height must be between 0 and %d, got %d", maxImageSize, opts.Height)
+	}
+
+	if opts.Fit != "" && !imageFits[opts.Fit] {
+		return fmt.Errorf("unknown fit %q", opts.Fit)
+	}
+
+	if opts.Fit != "" && opts.Width == 0 && opts.Height == 0 {
+		return fmt.Errorf("fit %q requires a width or a height", opts.Fit)
+	}
+
+	if opts.Focus != "" {
+		if !imageFocusAreas[opts.Focus] {
+			return fmt.Errorf("unknown focus area %q", opts.Focus)
+		}
+
+		if opts.Fit == "" || opts.Fit == "scale" {
+			return fmt.Errorf("focus requires fit pad, fill, crop or thumb")
+		}
+	}
+
+	if opts.Format != "" && !imageFormats[opts.Format] {
+		return fmt.Errorf("unknown format %q", opts.Format)
+	}
+
+	if opts.Progressive && opts.Format != "jpg" {
+		return fmt.Errorf("progressive requires format jpg")
+	}
+
+	if opts.Quality < 0 || opts.Quality > 100 {
+		return fmt.Errorf("quality must be between 1 and 100, got %d", opts.Quality)
+	}
+
+	if opts.Background != "" {
+		if !imageBackground.MatchString(opts.Background) {
+			return fmt.Errorf("background must be given as rgb:RRGGBB, got %q", opts.Background)
+		}
+
+		if opts.Fit != "pad" {
+			return fmt.Errorf("background requires fit pad")
+		}
+	}
+
+	return nil
+}
+
+// query returns the options as query params of the images api
+func (opts ImageOptions) query() url.Values {
+	query := url.Values{}
+
+	if opts.Width > 0 {
+		query.Set("w", strconv.Itoa(opts.Width))
+	}
+
+	if opts.Height > 0 {
+		query.Set("h", strconv.Itoa(opts.Height))
+	}
+
+	if opts.Fit != "" {
+		query.Set("fit", opts.Fit)
+	}
+
+	if opts.Focus != "" {
+		query.Set("f", opts.Focus)
+	}
+
+	if opts.Format != "" {
+		query.Set("fm", opts.Format)
+	}
+
+	if opts.Progressive {
+		query.Set("fl", "progressive")
+	}
+
+	if opts.Quality > 0 {
+		query.Set("q", strconv.Itoa(opts.Quality))
+	}
+
+	if opts.Background != "" {
+		query.Set("bg", opts.Background)
+	}
+
+	return query
+}
+
+// file returns the asset's file of the given locale
+func (asset *Asset) file(locale string) (*File, error) {
+	fields := asset.Fields
+	if asset.locale != "" && asset.locale != locale {
+		localized, ok := asset.Localized[locale]
+		if !ok {
+			return nil, fmt.Errorf("asset has no fields of locale %q", locale)
+		}
+
+		fields = localized
+	}
+
+	if fields == nil || fields.File == nil {
+		return nil, fmt.Errorf("asset has no file")
+	}
+
+	return fields.File, nil
+}
+
+// ImageURL returns the url of the asset's image of the given locale,
+// transformed by the images api as given by the options
+func (asset *Asset) ImageURL(locale string, opts ImageOptions) (string, error) {
+	file, err := asset.file(locale)
+	if err != nil {
+		return "", err
+	}
+
+	if file.URL == "" {
+		return "", fmt.Errorf("asset file is not processed yet")
+	}
+
+	if file.ContentType != "" && !strings.HasPrefix(file.ContentType, "image/") {
+		return "", fmt.Errorf("asset file of type %s is not an image", file.ContentType)
+	}
+
+	if err := opts.validate(); err != nil {
+		return "", err
+	}
+
+	u, err := url.Parse(file.URL)
+	if err != nil {
+		return "", err
+	}
+
+	query := u.Query()
+	for key, values := range opts.query() {
+		query[key] = values
+	}
+
+	u.RawQuery = query.Encode()
+
+	return u.String(), nil
+}
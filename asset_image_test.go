@@ -0,0 +1,114 @@
+package contentful
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func imageAsset() *Asset {
+	return &Asset{
+		locale: "en-US",
+		Fields: &FileFields{
+			File: &File{
+				Name:        "nyancat.png",
+				ContentType: "image/png",
+				URL:         "//images.ctfassets.net/cfexampleapi/nyancat/nyancat.png",
+			},
+		},
+	}
+}
+
+func TestAssetImageURL(t *testing.T) {
+	assert := assert.New(t)
+
+	base := "//images.ctfassets.net/cfexampleapi/nyancat/nyancat.png"
+
+	tests := []struct {
+		opts ImageOptions
+		url  string
+	}{
+		{ImageOptions{}, base},
+		{ImageOptions{Width: 200}, base + "?w=200"},
+		{ImageOptions{Width: 200, Height: 100, Fit: "thumb", Focus: "face"}, base + "?f=face&fit=thumb&h=100&w=200"},
+		{ImageOptions{Format: "jpg", Progressive: true, Quality: 80}, base + "?fl=progressive&fm=jpg&q=80"},
+		{ImageOptions{Width: 400, Fit: "pad", Background: "rgb:ff00ff"}, base + "?bg=rgb%3Aff00ff&fit=pad&w=400"},
+		{ImageOptions{Format: "webp"}, base + "?fm=webp"},
+	}
+
+	for _, test := range tests {
+		u, err := imageAsset().ImageURL("en-US", test.opts)
+		assert.Nil(err)
+		assert.Equal(test.url, u)
+	}
+}
+
+func TestAssetImageURLInvalid(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []ImageOptions{
+		{Width: -1},
+		{Height: 4001},
+		{Width: 100, Fit: "stretch"},
+		{Fit: "fill"},
+		{Width: 100, Focus: "face"},
+		{Width: 100, Fit: "scale", Focus: "face"},
+		{Width: 100, Fit: "crop", Focus: "middle"},
+		{Format: "bmp"},
+		{Format: "png", Progressive: true},
+		{Quality: 101},
+		{Width: 100, Fit: "fill", Background: "rgb:ff00ff"},
+		{Width: 100, Fit: "pad", Background: "#ff00ff"},
+	}
+
+	for _, opts := range tests {
+		_, err := imageAsset().ImageURL("en-US", opts)
+		assert.NotNil(err, "%+v", opts)
+	}
+
+	_, err := imageAsset().ImageURL("tlh", ImageOptions{})
+	assert.NotNil(err)
+
+	asset := imageAsset()
+	asset.Fields.File.URL = ""
+	_, err = asset.ImageURL("en-US", ImageOptions{})
+	assert.EqualError(err, "asset file is not processed yet")
+
+	asset = imageAsset()
+	asset.Fields.File.ContentType = "application/pdf"
+	_, err = asset.ImageURL("en-US", ImageOptions{})
+	assert.NotNil(err)
+}
+
+func TestAssetSecureFileURL(t *testing.T) {
+	assert := assert.New(t)
+
+	u, err := imageAsset().SecureFileURL("en-US")
+	assert.Nil(err)
+	assert.Equal("https://images.ctfassets.net/cfexampleapi/nyancat/nyancat.png", u)
+
+	asset := imageAsset()
+	asset.Fields.File.URL = "http://assets.ctfassets.net/cfexampleapi/nyancat/nyancat.png"
+	u, err = asset.SecureFileURL("en-US")
+	assert.Nil(err)
+	assert.Equal("https://assets.ctfassets.net/cfexampleapi/nyancat/nyancat.png", u)
+
+	asset = imageAsset()
+	asset.Fields.File.URL = ""
+	asset.Fields.File.UploadURL = "https://example.com/nyancat.png"
+	_, err = asset.SecureFileURL("en-US")
+	assert.EqualError(err, "asset file uploaded from https://example.com/nyancat.png is not processed yet")
+
+	asset = imageAsset()
+	asset.Fields.File.URL = "https://upload.contentful.com/spaces/cfexampleapi/uploads/nyancat"
+	_, err = asset.SecureFileURL("en-US")
+	assert.NotNil(err)
+
+	asset = imageAsset()
+	asset.Fields.File = nil
+	_, err = asset.SecureFileURL("en-US")
+	assert.EqualError(err, "asset has no file")
+
+	_, err = imageAsset().SecureFileURL("tlh")
+	assert.NotNil(err)
+}
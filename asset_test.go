@@ -1 +1,207 @@
-package contentful
\ No newline at end of file
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAssetsServiceCreateIdempotent(t *testing.T) {
+	assert := assert.New(t)
+
+	id := IdempotentID("upload-42")
+	created := false
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		checkHeaders(r, assert)
+		assert.Equal("/spaces/"+spaceID+"/environments/master/assets/"+id, r.URL.Path)
+
+		if r.Method == "PUT" {
+			if created {
+				w.WriteHeader(409)
+				fmt.Fprintln(w, `{"sys": {"type": "Error", "id": "VersionMismatch"}}`)
+				return
+			}
+
+			created = true
+			w.WriteHeader(201)
+		}
+
+		fmt.Fprintf(w, `{"sys": {"id": "%s", "version": 1, "createdAt": "2019-07-01T10:00:00Z"}, "fields": {"title": {"en-US": "Doge"}, "file": {"en-US": {"fileName": "doge.jpg", "contentType": "image/jpeg", "upload": "https://example.com/doge.jpg"}}}}`, id)
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	newAsset := func() *Asset {
+		return &Asset{
+			locale: "en-US",
+			Fields: &FileFields{
+				Title: "Doge",
+				File: &File{
+					Name:        "doge.jpg",
+					ContentType: "image/jpeg",
+					UploadURL:   "https://example.com/doge.jpg",
+				},
+			},
+		}
+	}
+
+	asset := newAsset()
+	assert.Nil(cma.Assets.CreateIdempotent(context.Background(), spaceID, "upload-42", asset))
+	assert.Equal(id, asset.Sys.ID)
+
+	// a replayed create returns the existing asset
+	replayed := newAsset()
+	assert.Nil(cma.Assets.CreateIdempotent(context.Background(), spaceID, "upload-42", replayed))
+	assert.Equal(id, replayed.Sys.ID)
+	assert.Equal("2019-07-01T10:00:00Z", replayed.Sys.CreatedAt)
+	assert.Equal("Doge", replayed.Fields.Title)
+}
+
+func TestAssetsServiceForceDelete(t *testing.T) {
+	assert := assert.New(t)
+
+	requests := []string{}
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path+" "+r.Header.Get("X-Contentful-Version"))
+
+		if r.URL.Path == "/spaces/"+spaceID+"/environments/master/assets/nyancat/published" {
+			fmt.Fprintln(w, `{"sys": {"id": "nyancat", "version": 8}, "fields": {"title": {"en-US": "Nyan Cat"}}}`)
+			return
+		}
+
+		if r.URL.Path == "/spaces/"+spaceID+"/environments/master/assets/nyancat" && r.Header.Get("X-Contentful-Version") != "8" {
+			w.WriteHeader(409)
+			fmt.Fprintln(w, `{"sys": {"type": "Error", "id": "VersionMismatch"}}`)
+			return
+		}
+
+		w.WriteHeader(204)
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	asset := &Asset{
+		locale: "en-US",
+		Sys:    &Sys{ID: "nyancat", Version: 7, PublishedAt: "2017-01-01T00:00:00Z"},
+		Fields: &FileFields{Title: "Nyan Cat"},
+	}
+	assert.Nil(cma.Assets.ForceDelete(context.Background(), spaceID, asset))
+	assert.Equal(8, asset.Sys.Version)
+
+	assert.Equal([]string{
+		"DELETE /spaces/" + spaceID + "/environments/master/assets/nyancat/published 7",
+		"DELETE /spaces/" + spaceID + "/environments/master/assets/nyancat 8",
+	}, requests)
+}
+
+func TestAssetsServiceWaitForProcessing(t *testing.T) {
+	assert := assert.New(t)
+
+	requests := 0
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("/spaces/"+spaceID+"/environments/master/assets/nyancat", r.URL.Path)
+		requests++
+
+		if requests == 1 {
+			fmt.Fprintln(w, `{"sys": {"id": "nyancat", "version": 2}, "fields": {"file": {"en-US": {"fileName": "nyan.gif", "upload": "https://upload.contentful.com/nyan.gif"}}}}`)
+			return
+		}
+
+		fmt.Fprintln(w, `{"sys": {"id": "nyancat", "version": 3}, "fields": {"file": {"en-US": {"fileName": "nyan.gif", "url": "//images.ctfassets.net/nyan.gif"}}}}`)
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	asset, err := cma.Assets.WaitForProcessing(context.Background(), spaceID, "nyancat", "en-US", time.Millisecond)
+	assert.Nil(err)
+	assert.Equal(2, requests)
+	assert.Equal(3, asset.Sys.Version)
+	assert.Equal("//images.ctfassets.net/nyan.gif", asset.Fields.File.URL)
+}
+
+func TestAssetsServiceWaitForProcessingTimeout(t *testing.T) {
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, `{"sys": {"id": "nyancat", "version": 2}, "fields": {"file": {"en-US": {"fileName": "nyan.gif", "upload": "https://upload.contentful.com/nyan.gif"}}}}`)
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	asset, err := cma.Assets.WaitForProcessing(ctx, spaceID, "nyancat", "en-US", 5*time.Millisecond)
+	assert.Nil(asset)
+	assert.True(errors.Is(err, context.DeadlineExceeded))
+	assert.Contains(err.Error(), "timed out waiting for the en-US file of asset nyancat")
+}
+
+func TestAssetLocalesRoundTrip(t *testing.T) {
+	assert := assert.New(t)
+
+	data := `{
+		"sys": {"id": "nyancat", "version": 3},
+		"fields": {
+			"title": {"en-US": "Nyan Cat", "de-DE": "Nyan Katze"},
+			"description": {"en-US": "A cat", "de-DE": "Eine Katze"},
+			"file": {
+				"en-US": {"fileName": "nyancat.gif", "contentType": "image/gif", "url": "//images.ctfassets.net/nyancat.gif"},
+				"de-DE": {"fileName": "nyankatze.gif", "contentType": "image/gif", "url": "//images.ctfassets.net/nyankatze.gif"}
+			}
+		}
+	}`
+
+	var asset Asset
+	assert.Nil(json.Unmarshal([]byte(data), &asset))
+
+	// the first locale is at hand, all of them are kept
+	assert.Equal("de-DE", asset.Locale())
+	assert.Equal("Nyan Katze", asset.Fields.Title)
+	assert.Equal(2, len(asset.Localized))
+	assert.Equal("Nyan Cat", asset.Localized["en-US"].Title)
+
+	url, err := asset.SecureFileURL("en-US")
+	assert.Nil(err)
+	assert.Equal("https://images.ctfassets.net/nyancat.gif", url)
+
+	// changes to the fields are written along with the other locales
+	asset.Fields.Title = "Regenbogen Katze"
+
+	byteArray, err := json.Marshal(&asset)
+	assert.Nil(err)
+
+	var written, expected map[string]interface{}
+	assert.Nil(json.Unmarshal(byteArray, &written))
+	assert.Nil(json.Unmarshal([]byte(strings.Replace(data, `"Nyan Katze"`, `"Regenbogen Katze"`, 1)), &expected))
+	assert.Equal(expected["fields"], written["fields"])
+}
@@ -0,0 +1,71 @@
+package contentful
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// CreateFromReader adds the file read from r as a new asset of the space's
+// default locale, e.g. a file posted to a multipart form. The file is
+// streamed to the upload host, attached to a new asset with the given title
+// and processed. The returned asset is awaiting processing, see
+// WaitForProcessing for its delivery url. The upload is sent once, it is not
+// retried since r can not be rewound.
+func (service *AssetsService) CreateFromReader(ctx context.Context, spaceID, title, fileName, contentType string, r io.Reader) (*Asset, error) {
+	locale, err := service.defaultLocale(ctx, spaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	upload, err := service.c.Uploads.create(ctx, spaceID, r)
+	if err != nil {
+		return nil, err
+	}
+
+	asset := &Asset{
+		locale: locale,
+		Sys:    &Sys{},
+		Fields: &FileFields{
+			Title: title,
+			File: &File{
+				Name:        fileName,
+				ContentType: contentType,
+				UploadFrom:  NewUploadLink(upload.Sys.ID),
+			},
+		},
+	}
+
+	if err := service.upsert(ctx, spaceID, asset); err != nil {
+		return nil, err
+	}
+
+	if err := service.process(ctx, spaceID, asset); err != nil {
+		return nil, err
+	}
+
+	return asset, nil
+}
+
+// defaultLocale returns the code of the space's default locale
+func (service *AssetsService) defaultLocale(ctx context.Context, spaceID string) (string, error) {
+	code := ""
+	err := eachPage(ctx, service.c.Locales.List(spaceID), func(col *Collection) error {
+		for _, locale := range col.ToLocale() {
+			if locale.Default {
+				code = locale.Code
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if code == "" {
+		return "", fmt.Errorf("space %s has no default locale", spaceID)
+	}
+
+	return code, nil
+}
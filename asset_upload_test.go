@@ -0,0 +1,93 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAssetsServiceCreateFromReader(t *testing.T) {
+	assert := assert.New(t)
+
+	var uploaded string
+	uploadServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("POST", r.Method)
+		assert.Equal("/spaces/"+spaceID+"/environments/master/uploads", r.URL.Path)
+
+		body, _ := ioutil.ReadAll(r.Body)
+		uploaded = string(body)
+
+		w.WriteHeader(201)
+		fmt.Fprintln(w, `{"sys": {"id": "upload-id", "type": "Upload"}}`)
+	}))
+	defer uploadServer.Close()
+
+	base := "/spaces/" + spaceID + "/environments/master"
+	var created map[string]interface{}
+	processed := false
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET " + base + "/locales":
+			fmt.Fprintln(w, `{"total": 2, "items": [
+				{"sys": {"id": "en"}, "code": "en-US"},
+				{"sys": {"id": "de"}, "code": "de-DE", "default": true}
+			]}`)
+		case "POST " + base + "/assets":
+			assert.Nil(json.NewDecoder(r.Body).Decode(&created))
+
+			w.WriteHeader(201)
+			fmt.Fprintln(w, `{
+				"sys": {"id": "nyancat", "version": 1, "createdAt": "2018-01-01T00:00:00Z"},
+				"fields": {
+					"title": {"de-DE": "Nyan Cat"},
+					"file": {"de-DE": {"fileName": "nyancat.gif", "contentType": "image/gif", "uploadFrom": {"sys": {"type": "Link", "linkType": "Upload", "id": "upload-id"}}}}
+				}
+			}`)
+		case "PUT " + base + "/assets/nyancat/files/de-DE/process":
+			assert.Equal("1", r.Header.Get("X-Contentful-Version"))
+			processed = true
+			w.WriteHeader(204)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer apiServer.Close()
+
+	cma = NewCMA(CMAToken, WithBaseURL(apiServer.URL), WithUploadURL(uploadServer.URL))
+
+	// the reader is streamed, its length is not known up front
+	pr, pw := io.Pipe()
+	go func() {
+		io.Copy(pw, strings.NewReader("GIF89a nyan"))
+		pw.Close()
+	}()
+
+	asset, err := cma.Assets.CreateFromReader(context.Background(), spaceID, "Nyan Cat", "nyancat.gif", "image/gif", pr)
+	assert.Nil(err)
+	assert.Equal("GIF89a nyan", uploaded)
+	assert.True(processed)
+
+	// the file is created in the default locale from the upload
+	assert.Equal(map[string]interface{}{
+		"title":       map[string]interface{}{"de-DE": "Nyan Cat"},
+		"description": map[string]interface{}{"de-DE": ""},
+		"file": map[string]interface{}{"de-DE": map[string]interface{}{
+			"fileName":    "nyancat.gif",
+			"contentType": "image/gif",
+			"uploadFrom":  map[string]interface{}{"sys": map[string]interface{}{"type": "Link", "linkType": "Upload", "id": "upload-id"}},
+		}},
+	}, created["fields"])
+
+	assert.Equal("nyancat", asset.Sys.ID)
+	assert.Equal("Nyan Cat", asset.Fields.Title)
+	assert.Equal("upload-id", asset.Fields.File.UploadFrom.Sys.ID)
+	assert.Equal("", asset.Fields.File.URL)
+}
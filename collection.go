@@ -2,27 +2,90 @@ package contentful
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"reflect"
+	"strings"
 )
 
+const (
+	// SortCreatedAt orders by creation time
+	SortCreatedAt = "sys.createdAt"
+
+	// SortUpdatedAt orders by the time of the last update
+	SortUpdatedAt = "sys.updatedAt"
+
+	// SortPublishedAt orders by the time of the last publish
+	SortPublishedAt = "sys.publishedAt"
+
+	// SortFirstPublishedAt orders by the time of the first publish
+	SortFirstPublishedAt = "sys.firstPublishedAt"
+
+	// SortID orders by id
+	SortID = "sys.id"
+
+	// SortVersion orders by version
+	SortVersion = "sys.version"
+
+	// SortRevision orders by the published revision, delivery api only
+	SortRevision = "sys.revision"
+
+	// SortContentType orders entries by their content type id
+	SortContentType = "sys.contentType.sys.id"
+)
+
+var sortKeys = map[string]bool{
+	SortCreatedAt:        true,
+	SortUpdatedAt:        true,
+	SortPublishedAt:      true,
+	SortFirstPublishedAt: true,
+	SortID:               true,
+	SortVersion:          true,
+	SortRevision:         true,
+	SortContentType:      true,
+}
+
 // CollectionOptions holds init options
 type CollectionOptions struct {
 	Limit uint16
 }
 
-// Collection model
+// Collection model. The To* methods decode the items of a page once, further
+// calls return the same models until the next page is fetched.
 type Collection struct {
 	Query
 	c        *Client
 	req      *http.Request
 	page     uint16
-	Sys      *Sys          `json:"sys"`
-	Total    int           `json:"total"`
-	Skip     int           `json:"skip"`
-	Limit    int           `json:"limit"`
-	Items    []interface{} `json:"items"`
-	Includes interface{}   `json:"includes"`
+	ordered  bool
+	resolve  *bool
+	Sys      *Sys               `json:"sys"`
+	Total    int                `json:"total"`
+	Skip     int                `json:"skip"`
+	Limit    int                `json:"limit"`
+	Items    []interface{}      `json:"items"`
+	Includes interface{}        `json:"includes"`
+	Errors   []*CollectionError `json:"errors,omitempty"`
+
+	// decoded holds the items decoded by the To* methods by type
+	decoded   map[reflect.Type]interface{}
+	useNumber bool
+}
+
+// CollectionError model, an item the api could not resolve, e.g. a link to
+// an entry which is not published
+type CollectionError struct {
+	Sys     *Sys                    `json:"sys"`
+	Details *CollectionErrorDetails `json:"details,omitempty"`
+}
+
+// CollectionErrorDetails model, the link which could not be resolved
+type CollectionErrorDetails struct {
+	Type     string `json:"type,omitempty"`
+	LinkType string `json:"linkType,omitempty"`
+	ID       string `json:"id,omitempty"`
 }
 
 // NewCollection initilazies a new collection
@@ -40,42 +103,175 @@ func NewCollection(options *CollectionOptions) *Collection {
 	}
 }
 
-// Next makes the col.req
+// WithContext makes the collection's requests with ctx, e.g. to cancel them
+// or to apply the overrides of WithRequestEnvironment and WithRequestHeader
+func (col *Collection) WithContext(ctx context.Context) *Collection {
+	if col.req != nil {
+		col.req = withContext(col.req, ctx)
+	}
+
+	return col
+}
+
+// Next makes the col.req. Pages are fetched by skip and limit, so entities
+// created or deleted while paging shift the following pages and items are
+// fetched twice or missed, see Stable.
 func (col *Collection) Next() (*Collection, error) {
 	// setup query params
 	skip := uint16(col.Limit) * (col.page - 1)
 	col.Query.Skip(skip)
 
 	// override request query
-	col.req.URL.RawQuery = col.Query.String()
+	rawQuery, err := col.rawQuery()
+	if err != nil {
+		return nil, err
+	}
+	col.req.URL.RawQuery = rawQuery
+
+	// errors are reported by the pages they occur on only
+	col.Errors = nil
+	col.decoded = nil
 
 	// makes api call
-	err := col.c.do(col.req, col)
-	if err != nil {
+	if err := col.c.do(col.req, col); err != nil {
 		return nil, err
 	}
 
+	col.resolveItemLinks()
 	col.page++
 
 	return col, nil
 }
 
+// OrderBy orders the collection by the given sys key, e.g. SortUpdatedAt,
+// or by a field given as "fields.<id>". The first call replaces the default
+// order, further calls add secondary orders. Unknown keys are rejected
+// before any request is sent.
+func (col *Collection) OrderBy(key string, desc bool) error {
+	if !sortKeys[key] && (!strings.HasPrefix(key, "fields.") || key == "fields.") {
+		return fmt.Errorf("%q is not a sortable key", key)
+	}
+
+	if !col.ordered {
+		col.Query.order = []string{}
+		col.ordered = true
+	}
+
+	col.Query.Order(key, desc)
+
+	return nil
+}
+
+// HasMore reports whether there are items left after the fetched page
+func (col *Collection) HasMore() bool {
+	return col.Skip+len(col.Items) < col.Total
+}
+
 // Fetch makes the col.req without pagination
 func (col *Collection) Fetch() (*Collection, error) {
 	// override request query
-	col.req.URL.RawQuery = col.Query.String()
+	rawQuery, err := col.rawQuery()
+	if err != nil {
+		return col, err
+	}
+	col.req.URL.RawQuery = rawQuery
+	col.Errors = nil
+	col.decoded = nil
 
 	// makes api call
-	err := col.c.do(col.req, col)
-	return col, err
+	if err := col.c.do(col.req, col); err != nil {
+		return col, err
+	}
+
+	col.resolveItemLinks()
+
+	return col, nil
+}
+
+// decodeItems decodes the items into v, a pointer to a slice. Items are
+// decoded once per type and page, further calls set v to the slice decoded
+// first and report true.
+func (col *Collection) decodeItems(v interface{}) bool {
+	cached, _ := col.decodeItemsErr(v)
+
+	return cached
+}
+
+// decodeItemsErr decodes the items like decodeItems, and returns the
+// decoding error. Slices failing to decode are not cached.
+func (col *Collection) decodeItemsErr(v interface{}) (bool, error) {
+	value := reflect.ValueOf(v).Elem()
+
+	if decoded, ok := col.decoded[value.Type()]; ok {
+		value.Set(reflect.ValueOf(decoded))
+		return true, nil
+	}
+
+	byteArray, err := json.Marshal(col.Items)
+	if err != nil {
+		return false, err
+	}
+
+	if col.useNumber {
+		err = decodeItemsUsingNumber(byteArray, value)
+	} else {
+		err = json.NewDecoder(bytes.NewReader(byteArray)).Decode(v)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if col.decoded == nil {
+		col.decoded = map[reflect.Type]interface{}{}
+	}
+	col.decoded[value.Type()] = value.Interface()
+
+	return false, nil
+}
+
+func (col *Collection) useNumbers() {
+	col.useNumber = true
+}
+
+// decodeItemsUsingNumber decodes the items into the slice, items decoding
+// numbers themselves, i.e. entries, are told to use json.Number
+func decodeItemsUsingNumber(byteArray []byte, slice reflect.Value) error {
+	var items []json.RawMessage
+
+	dec := json.NewDecoder(bytes.NewReader(byteArray))
+	dec.UseNumber()
+	if err := dec.Decode(&items); err != nil {
+		return err
+	}
+
+	slice.Set(reflect.MakeSlice(slice.Type(), 0, len(items)))
+	for _, item := range items {
+		elem := reflect.New(slice.Type().Elem())
+		if elem.Elem().Kind() == reflect.Ptr {
+			elem.Elem().Set(reflect.New(elem.Elem().Type().Elem()))
+		}
+
+		if decoder, ok := elem.Elem().Interface().(numberDecoder); ok {
+			decoder.useNumbers()
+		}
+
+		dec := json.NewDecoder(bytes.NewReader(item))
+		dec.UseNumber()
+		if err := dec.Decode(elem.Interface()); err != nil {
+			return err
+		}
+
+		slice.Set(reflect.Append(slice, elem.Elem()))
+	}
+
+	return nil
 }
 
 // ToContentType cast Items to ContentType model
 func (col *Collection) ToContentType() []*ContentType {
 	var contentTypes []*ContentType
 
-	byteArray, _ := json.Marshal(col.Items)
-	json.NewDecoder(bytes.NewReader(byteArray)).Decode(&contentTypes)
+	col.decodeItems(&contentTypes)
 
 	return contentTypes
 }
@@ -84,8 +280,7 @@ func (col *Collection) ToContentType() []*ContentType {
 func (col *Collection) ToSpace() []*Space {
 	var spaces []*Space
 
-	byteArray, _ := json.Marshal(col.Items)
-	json.NewDecoder(bytes.NewReader(byteArray)).Decode(&spaces)
+	col.decodeItems(&spaces)
 
 	return spaces
 }
@@ -94,8 +289,15 @@ func (col *Collection) ToSpace() []*Space {
 func (col *Collection) ToEntry() []*Entry {
 	var entries []*Entry
 
-	byteArray, _ := json.Marshal(col.Items)
-	json.NewDecoder(bytes.NewReader(byteArray)).Decode(&entries)
+	if col.decodeItems(&entries) {
+		return entries
+	}
+
+	// entries of a single locale collection hold the locale's values only
+	for _, entry := range entries {
+		entry.locale = col.Query.locale
+		entry.flattenLocale()
+	}
 
 	return entries
 }
@@ -104,8 +306,7 @@ func (col *Collection) ToEntry() []*Entry {
 func (col *Collection) ToLocale() []*Locale {
 	var locales []*Locale
 
-	byteArray, _ := json.Marshal(col.Items)
-	json.NewDecoder(bytes.NewReader(byteArray)).Decode(&locales)
+	col.decodeItems(&locales)
 
 	return locales
 }
@@ -114,8 +315,7 @@ func (col *Collection) ToLocale() []*Locale {
 func (col *Collection) ToAsset() []*Asset {
 	var assets []*Asset
 
-	byteArray, _ := json.Marshal(col.Items)
-	json.NewDecoder(bytes.NewReader(byteArray)).Decode(&assets)
+	col.decodeItems(&assets)
 
 	return assets
 }
@@ -124,8 +324,7 @@ func (col *Collection) ToAsset() []*Asset {
 func (col *Collection) ToAPIKey() []*APIKey {
 	var apiKeys []*APIKey
 
-	byteArray, _ := json.Marshal(col.Items)
-	json.NewDecoder(bytes.NewReader(byteArray)).Decode(&apiKeys)
+	col.decodeItems(&apiKeys)
 
 	return apiKeys
 }
@@ -134,8 +333,106 @@ func (col *Collection) ToAPIKey() []*APIKey {
 func (col *Collection) ToWebhook() []*Webhook {
 	var webhooks []*Webhook
 
-	byteArray, _ := json.Marshal(col.Items)
-	json.NewDecoder(bytes.NewReader(byteArray)).Decode(&webhooks)
+	col.decodeItems(&webhooks)
 
 	return webhooks
 }
+
+// ToEnvironmentAlias cast Items to EnvironmentAlias model
+func (col *Collection) ToEnvironmentAlias() []*EnvironmentAlias {
+	var aliases []*EnvironmentAlias
+
+	col.decodeItems(&aliases)
+
+	return aliases
+}
+
+// ToPersonalAccessToken cast Items to PersonalAccessToken model
+func (col *Collection) ToPersonalAccessToken() []*PersonalAccessToken {
+	var tokens []*PersonalAccessToken
+
+	col.decodeItems(&tokens)
+
+	return tokens
+}
+
+// ToExtension cast Items to Extension model
+func (col *Collection) ToExtension() []*Extension {
+	var extensions []*Extension
+
+	col.decodeItems(&extensions)
+
+	return extensions
+}
+
+// ToAppInstallation cast Items to AppInstallation model
+func (col *Collection) ToAppInstallation() []*AppInstallation {
+	var installations []*AppInstallation
+
+	col.decodeItems(&installations)
+
+	return installations
+}
+
+// ToAppDefinition cast Items to AppDefinition model
+func (col *Collection) ToAppDefinition() []*AppDefinition {
+	var definitions []*AppDefinition
+
+	col.decodeItems(&definitions)
+
+	return definitions
+}
+
+// ToPeriodicUsage cast Items to PeriodicUsage model
+func (col *Collection) ToPeriodicUsage() []*PeriodicUsage {
+	var usages []*PeriodicUsage
+
+	col.decodeItems(&usages)
+
+	return usages
+}
+
+// ToComment cast Items to Comment model
+func (col *Collection) ToComment() []*Comment {
+	var comments []*Comment
+
+	col.decodeItems(&comments)
+
+	return comments
+}
+
+// ToTask cast Items to Task model
+func (col *Collection) ToTask() []*Task {
+	var tasks []*Task
+
+	col.decodeItems(&tasks)
+
+	return tasks
+}
+
+// ToSnapshot cast Items to Snapshot model
+func (col *Collection) ToSnapshot() []*Snapshot {
+	var snapshots []*Snapshot
+
+	col.decodeItems(&snapshots)
+
+	return snapshots
+}
+
+// ToContentTypeSnapshot cast Items to ContentTypeSnapshot model
+func (col *Collection) ToContentTypeSnapshot() []*ContentTypeSnapshot {
+	var snapshots []*ContentTypeSnapshot
+
+	col.decodeItems(&snapshots)
+
+	return snapshots
+}
+
+// ToRelease cast Items to Release model
+func (col *Collection) ToRelease() []*Release {
+	var releases []*Release
+
+	col.decodeItems(&releases)
+
+	return releases
+}
@@ -0,0 +1,36 @@
+package contentful
+
+// CollectionOf decodes the items of the collection into a slice of the given
+// model, e.g.
+//
+//	entries, err := CollectionOf[*Entry](col)
+//
+// Unlike the To* methods, decoding errors are returned. Items are decoded
+// once per model like with the To* methods, and entries keep their numbers
+// as json.Number on clients created WithUseNumber.
+func CollectionOf[T any](col *Collection) ([]T, error) {
+	items := []T{}
+
+	cached, err := col.decodeItemsErr(&items)
+	if err != nil {
+		return nil, err
+	}
+
+	if items == nil {
+		items = []T{}
+	}
+
+	if cached {
+		return items, nil
+	}
+
+	// entries of a single locale collection hold the locale's values only
+	for _, item := range items {
+		if entry, ok := any(item).(*Entry); ok && entry != nil {
+			entry.locale = col.Query.locale
+			entry.flattenLocale()
+		}
+	}
+
+	return items, nil
+}
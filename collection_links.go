@@ -0,0 +1,138 @@
+package contentful
+
+import (
+	"net/url"
+)
+
+// ResolveLinks controls how links between the items of the collection are
+// returned. When resolve is true, the links in the fields of the items are
+// replaced by the entries and assets they link to, taken from the page and
+// its includes, down to the include depth, 1 unless set with Include. Links
+// which can not be resolved are kept, see Errors. When resolve is false, the
+// api is asked not to include linked entities at all and links are left as
+// they are, e.g. for syncing entries into a database.
+func (col *Collection) ResolveLinks(resolve bool) *Collection {
+	col.resolve = &resolve
+
+	return col
+}
+
+// rawQuery returns the query of the collection's requests, invalid queries
+// are rejected before they are sent
+func (col *Collection) rawQuery() (string, error) {
+	if err := col.Query.Err(); err != nil {
+		return "", err
+	}
+
+	values := col.Query.Values()
+
+	if col.resolve != nil && !*col.resolve {
+		values.Set("include", "0")
+	}
+
+	return values.Encode(), nil
+}
+
+// resolveItemLinks replaces the links in the fields of the items by the
+// entities they link to, if link resolution is enabled
+func (col *Collection) resolveItemLinks() {
+	if col.resolve == nil || !*col.resolve {
+		return
+	}
+
+	depth := int(col.Query.include)
+	if depth == 0 {
+		depth = 1
+	}
+
+	entities := map[string]map[string]interface{}{}
+	add := func(values []interface{}) {
+		for _, value := range values {
+			entity, ok := value.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			if sys, ok := entity["sys"].(map[string]interface{}); ok {
+				entities[linkKey(sys["type"], sys["id"])] = entity
+			}
+		}
+	}
+
+	add(col.Items)
+	if includes, ok := col.Includes.(map[string]interface{}); ok {
+		for _, linkType := range []string{LinkTypeEntry, LinkTypeAsset} {
+			if values, ok := includes[linkType].([]interface{}); ok {
+				add(values)
+			}
+		}
+	}
+
+	resolved := make([]interface{}, len(col.Items))
+	for i, item := range col.Items {
+		resolved[i] = resolveEntity(item, entities, depth)
+	}
+
+	col.Items = resolved
+}
+
+// resolveEntity returns a copy of the entity with the links in its fields
+// resolved down to the given depth. Linked entities are copied as well, so
+// that entities linking to each other don't make a cycle.
+func resolveEntity(value interface{}, entities map[string]map[string]interface{}, depth int) interface{} {
+	entity, ok := value.(map[string]interface{})
+	if !ok {
+		return value
+	}
+
+	resolved := make(map[string]interface{}, len(entity))
+	for key, val := range entity {
+		resolved[key] = val
+	}
+
+	if fields, ok := entity["fields"]; ok {
+		resolved["fields"] = resolveLinks(fields, entities, depth)
+	}
+
+	return resolved
+}
+
+func resolveLinks(value interface{}, entities map[string]map[string]interface{}, depth int) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if sys, ok := v["sys"].(map[string]interface{}); ok && sys["type"] == "Link" {
+			if depth == 0 {
+				return v
+			}
+
+			if entity, ok := entities[linkKey(sys["linkType"], sys["id"])]; ok {
+				return resolveEntity(entity, entities, depth-1)
+			}
+
+			return v
+		}
+
+		resolved := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			resolved[key] = resolveLinks(val, entities, depth)
+		}
+
+		return resolved
+	case []interface{}:
+		resolved := make([]interface{}, len(v))
+		for i, val := range v {
+			resolved[i] = resolveLinks(val, entities, depth)
+		}
+
+		return resolved
+	default:
+		return value
+	}
+}
+
+func linkKey(linkType, id interface{}) string {
+	typ, _ := linkType.(string)
+	key, _ := id.(string)
+
+	return url.PathEscape(typ) + "/" + url.PathEscape(key)
+}
@@ -0,0 +1,87 @@
+package contentful
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func linkResolutionServer(t *testing.T, include string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, include, r.URL.Query().Get("include"))
+		fmt.Fprintln(w, readTestData("entries-includes.json"))
+	}))
+}
+
+func TestCollectionResolveLinks(t *testing.T) {
+	assert := assert.New(t)
+
+	server := linkResolutionServer(t, "")
+	defer server.Close()
+
+	cda := NewCDA(CDAToken, WithBaseURL(server.URL))
+
+	col, err := cda.Entries.List(spaceID).ResolveLinks(true).Next()
+	assert.Nil(err)
+
+	entries := col.ToEntry()
+	assert.Equal(2, len(entries))
+
+	// links of the items are resolved from the page and its includes
+	bestFriend := entries[0].Fields["bestFriend"].(map[string]interface{})
+	assert.Equal("Nyan Cat", bestFriend["fields"].(map[string]interface{})["name"])
+
+	images := entries[0].Fields["images"].([]interface{})
+	assert.Equal("Happy Cat", images[0].(map[string]interface{})["fields"].(map[string]interface{})["title"])
+
+	// links of the linked entries are not, the include depth is 1
+	link := bestFriend["fields"].(map[string]interface{})["bestFriend"].(map[string]interface{})
+	assert.Equal("Link", link["sys"].(map[string]interface{})["type"])
+}
+
+func TestCollectionResolveLinksDepth(t *testing.T) {
+	assert := assert.New(t)
+
+	server := linkResolutionServer(t, "2")
+	defer server.Close()
+
+	cda := NewCDA(CDAToken, WithBaseURL(server.URL))
+
+	col := cda.Entries.List(spaceID).ResolveLinks(true)
+	col.Query.Include(2)
+
+	_, err := col.Next()
+	assert.Nil(err)
+
+	// happycat -> nyancat -> happycat -> link to nyancat
+	entries := col.ToEntry()
+	bestFriend := entries[0].Fields["bestFriend"].(map[string]interface{})
+	self := bestFriend["fields"].(map[string]interface{})["bestFriend"].(map[string]interface{})
+	assert.Equal("Happy Cat", self["fields"].(map[string]interface{})["name"])
+
+	link := self["fields"].(map[string]interface{})["bestFriend"].(map[string]interface{})
+	assert.Equal("Link", link["sys"].(map[string]interface{})["type"])
+}
+
+func TestCollectionRawLinks(t *testing.T) {
+	assert := assert.New(t)
+
+	server := linkResolutionServer(t, "0")
+	defer server.Close()
+
+	cda := NewCDA(CDAToken, WithBaseURL(server.URL))
+
+	col, err := cda.Entries.List(spaceID).ResolveLinks(false).Fetch()
+	assert.Nil(err)
+
+	entries := col.ToEntry()
+	bestFriend := entries[0].Fields["bestFriend"].(map[string]interface{})
+	assert.Equal(map[string]interface{}{
+		"type":     "Link",
+		"linkType": "Entry",
+		"id":       "nyancat",
+	}, bestFriend["sys"])
+}
@@ -0,0 +1,78 @@
+package contentful
+
+import (
+	"context"
+	"time"
+)
+
+// StableIterator pages through a collection in creation order, see
+// Collection.Stable
+type StableIterator struct {
+	col     *Collection
+	started bool
+	err     error
+}
+
+// Stable returns an iterator over the pages of the collection which is not
+// thrown off by entities created while iterating. The api pages by skip and
+// limit only, and the default order puts new entities first, so that each of
+// them shifts the following pages by one and an item is fetched twice.
+// Stable orders by creation time, oldest first, and leaves out entities
+// created after the iteration started. Deleting entities while iterating
+// still shifts the following pages, so items may be missed then.
+//
+//	it := cma.Entries.List(spaceID).Stable(ctx)
+//	for it.Next() {
+//		entries := it.Collection().ToEntry()
+//	}
+//	if err := it.Err(); err != nil {
+//		log.Fatal(err)
+//	}
+func (col *Collection) Stable(ctx context.Context) *StableIterator {
+	col.Query.order = []string{}
+	col.Query.Order(SortCreatedAt, false)
+	col.Query.Order(SortID, false)
+	col.ordered = true
+
+	// the api compares to the second, rounding up keeps the entities created
+	// within the current second, which are last in order anyway
+	snapshot := time.Now().UTC().Truncate(time.Second).Add(time.Second)
+	col.Query.LessThanOrEqual(SortCreatedAt, snapshot)
+
+	if col.req != nil {
+		col.req = withContext(col.req, ctx)
+	}
+
+	return &StableIterator{col: col}
+}
+
+// Next fetches the next page, it returns false when there are no more pages
+// or the fetch failed, see Err
+func (it *StableIterator) Next() bool {
+	if it.err != nil || (it.started && !it.col.HasMore()) {
+		return false
+	}
+
+	if it.col.req == nil {
+		return false
+	}
+
+	if _, err := it.col.Next(); err != nil {
+		it.err = err
+		return false
+	}
+
+	it.started = true
+
+	return len(it.col.Items) > 0
+}
+
+// Collection returns the page fetched by the last call to Next
+func (it *StableIterator) Collection() *Collection {
+	return it.col
+}
+
+// Err returns the error of the failed fetch, if any
+func (it *StableIterator) Err() error {
+	return it.err
+}
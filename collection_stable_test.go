@@ -0,0 +1,103 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCollectionStable(t *testing.T) {
+	assert := assert.New(t)
+
+	type item struct {
+		Sys *Sys `json:"sys"`
+	}
+
+	now := time.Now().UTC()
+	items := []item{}
+	for i := 0; i < 5; i++ {
+		items = append(items, item{Sys: &Sys{
+			ID:        fmt.Sprintf("cat%d", i),
+			CreatedAt: now.Add(time.Duration(i-10) * time.Minute).Format(time.RFC3339),
+		}})
+	}
+
+	requests := 0
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		assert.Equal("sys.createdAt,sys.id", query.Get("order"))
+
+		snapshot, err := time.Parse("2006-01-02 15:04:05", query.Get("sys.createdAt[lte]"))
+		assert.Nil(err)
+
+		// an entry is created between the pages
+		requests++
+		if requests == 2 {
+			items = append(items, item{Sys: &Sys{
+				ID:        "newcat",
+				CreatedAt: now.Add(time.Minute).Format(time.RFC3339),
+			}})
+		}
+
+		matching := []item{}
+		for _, it := range items {
+			createdAt, _ := time.Parse(time.RFC3339, it.Sys.CreatedAt)
+			if !createdAt.After(snapshot) {
+				matching = append(matching, it)
+			}
+		}
+
+		sort.Slice(matching, func(i, j int) bool {
+			return matching[i].Sys.CreatedAt < matching[j].Sys.CreatedAt
+		})
+
+		skip, _ := strconv.Atoi(query.Get("skip"))
+		limit, _ := strconv.Atoi(query.Get("limit"))
+
+		page := []item{}
+		if skip < len(matching) {
+			page = matching[skip:]
+		}
+		if len(page) > limit {
+			page = page[:limit]
+		}
+
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"total": len(matching),
+			"skip":  skip,
+			"limit": limit,
+			"items": page,
+		})
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	col := cma.Entries.List(spaceID)
+	col.Query.Limit(2)
+
+	ids := []string{}
+	it := col.Stable(context.Background())
+	for it.Next() {
+		for _, entry := range it.Collection().ToEntry() {
+			ids = append(ids, entry.Sys.ID)
+		}
+	}
+
+	assert.Nil(it.Err())
+	assert.Equal([]string{"cat0", "cat1", "cat2", "cat3", "cat4"}, ids)
+	assert.Equal(3, requests)
+}
@@ -0,0 +1,146 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Stream fetches the remaining pages of the collection and calls fn with
+// each item as it is read from the response, so that pages are never held
+// in memory as a whole. Items are passed as returned by the api, links are
+// not resolved. Streaming stops at the first error returned by fn, which is
+// returned by Stream.
+//
+//	err := cma.Entries.List(spaceID).Stream(ctx, func(item json.RawMessage) error {
+//		var entry contentful.Entry
+//		if err := json.Unmarshal(item, &entry); err != nil {
+//			return err
+//		}
+//
+//		return export(&entry)
+//	})
+func (col *Collection) Stream(ctx context.Context, fn func(json.RawMessage) error) error {
+	if col.req == nil {
+		return fmt.Errorf("collection has no request")
+	}
+
+	col.req = withContext(col.req, ctx)
+
+	skip := uint16(col.Limit) * (col.page - 1)
+	for {
+		col.Query.Skip(skip)
+		rawQuery, err := col.rawQuery()
+		if err != nil {
+			return err
+		}
+		col.req.URL.RawQuery = rawQuery
+		col.Errors = nil
+		col.decoded = nil
+
+		page := &collectionStream{col: col, fn: fn}
+		if err := col.c.do(col.req, page); err != nil {
+			return err
+		}
+
+		col.page++
+		skip += uint16(page.count)
+
+		if page.count == 0 || int(skip) >= col.Total {
+			return nil
+		}
+	}
+}
+
+// collectionStream decodes a collection page item by item
+type collectionStream struct {
+	col   *Collection
+	fn    func(json.RawMessage) error
+	count int
+}
+
+func (stream *collectionStream) decodeBody(body io.Reader) error {
+	col := stream.col
+	dec := json.NewDecoder(body)
+
+	if err := expectDelim(dec, '{'); err != nil {
+		return err
+	}
+
+	for dec.More() {
+		token, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		var target interface{}
+		switch token {
+		case "items":
+			if err := stream.decodeItems(dec); err != nil {
+				return err
+			}
+			continue
+		case "sys":
+			target = &col.Sys
+		case "total":
+			target = &col.Total
+		case "skip":
+			target = &col.Skip
+		case "limit":
+			target = &col.Limit
+		case "errors":
+			target = &col.Errors
+		default:
+			// includes are skipped, items are not resolved
+			target = &json.RawMessage{}
+		}
+
+		if err := dec.Decode(target); err != nil {
+			return err
+		}
+	}
+
+	col.Items = nil
+
+	return expectDelim(dec, '}')
+}
+
+func (stream *collectionStream) decodeItems(dec *json.Decoder) error {
+	token, err := dec.Token()
+	if err != nil || token == nil {
+		return err
+	}
+
+	if token != json.Delim('[') {
+		return fmt.Errorf("unexpected token %v, expected [", token)
+	}
+
+	for dec.More() {
+		var item json.RawMessage
+		if err := dec.Decode(&item); err != nil {
+			return err
+		}
+
+		if err := stream.fn(item); err != nil {
+			return err
+		}
+
+		stream.count++
+	}
+
+	return expectDelim(dec, ']')
+}
+
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	token, err := dec.Token()
+	if err != nil {
+		return err
+	}
+
+	if token != delim {
+		return fmt.Errorf("unexpected token %v, expected %v", token, delim)
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package contentful
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// streamServer serves total synthetic entries of about 1kb each, pages are
+// written as they are generated
+func streamServer(total int) (*httptest.Server, *int) {
+	requests := 0
+	padding := strings.Repeat("x", 1000)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		skip, _ := strconv.Atoi(r.URL.Query().Get("skip"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		end := skip + limit
+		if end > total {
+			end = total
+		}
+
+		out := bufio.NewWriter(w)
+		fmt.Fprintf(out, `{"sys":{"type":"Array"},"total":%d,"skip":%d,"limit":%d,"items":[`, total, skip, limit)
+		for i := skip; i < end; i++ {
+			if i > skip {
+				out.WriteString(",")
+			}
+			fmt.Fprintf(out, `{"sys":{"id":"entry%d","type":"Entry"},"fields":{"body":{"en-US":"%s"}}}`, i, padding)
+		}
+		out.WriteString(`],"includes":{"Entry":[]}}`)
+		out.Flush()
+	}))
+
+	return server, &requests
+}
+
+func TestCollectionStream(t *testing.T) {
+	assert := assert.New(t)
+
+	const total = 20000
+
+	server, requests := streamServer(total)
+	defer server.Close()
+
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	var before, stats runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+	peak := before.HeapAlloc
+
+	count := 0
+	col := cma.Entries.List(spaceID)
+	col.Query.Limit(1000)
+	err := col.Stream(context.Background(), func(item json.RawMessage) error {
+		var entry Entry
+		if err := json.Unmarshal(item, &entry); err != nil {
+			return err
+		}
+
+		if entry.Sys.ID != fmt.Sprintf("entry%d", count) {
+			return fmt.Errorf("unexpected entry %s", entry.Sys.ID)
+		}
+		count++
+
+		if count%2000 == 0 {
+			runtime.GC()
+			runtime.ReadMemStats(&stats)
+			if stats.HeapAlloc > peak {
+				peak = stats.HeapAlloc
+			}
+		}
+
+		return nil
+	})
+
+	assert.Nil(err)
+	assert.Equal(total, count)
+	assert.Equal(total/1000, *requests)
+	assert.Equal(total, col.Total)
+	assert.Nil(col.Items)
+
+	// the responses add up to about 20mb, of which a page at most is held
+	assert.True(peak-before.HeapAlloc < 10<<20, "heap grew by %d bytes", peak-before.HeapAlloc)
+}
+
+func TestCollectionStreamError(t *testing.T) {
+	assert := assert.New(t)
+
+	server, requests := streamServer(100)
+	defer server.Close()
+
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	stop := errors.New("stop")
+	count := 0
+
+	col := cma.Entries.List(spaceID)
+	col.Query.Limit(10)
+	err := col.Stream(context.Background(), func(item json.RawMessage) error {
+		count++
+		if count == 15 {
+			return stop
+		}
+
+		return nil
+	})
+
+	assert.Equal(stop, err)
+	assert.Equal(15, count)
+	assert.Equal(2, *requests)
+}
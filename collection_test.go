@@ -1,8 +1,263 @@
 package contentful
 
-import "testing"
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
 
 func TestNewCollection(t *testing.T) {
 	setup()
 	defer teardown()
 }
+
+func TestCollectionHasMore(t *testing.T) {
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(200)
+		fmt.Fprintln(w, readTestData("entries-page.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	col, err := cma.Entries.List(spaceID).Fetch()
+	assert.Nil(err)
+	assert.Equal(123, col.Total)
+	assert.Equal(100, col.Skip)
+	assert.Equal(2, col.Limit)
+	assert.Equal(2, len(col.Items))
+	assert.True(col.HasMore())
+
+	col.Skip = 121
+	assert.False(col.HasMore())
+}
+
+func collectionFromTestData(fileName string) (*Collection, error) {
+	col := NewCollection(&CollectionOptions{})
+	if err := json.Unmarshal([]byte(readTestData(fileName)), col); err != nil {
+		return nil, err
+	}
+
+	return col, nil
+}
+
+func TestCollectionToEntry(t *testing.T) {
+	assert := assert.New(t)
+
+	col, err := collectionFromTestData("spaces-id1-entries.json")
+	assert.Nil(err)
+
+	entries := col.ToEntry()
+	assert.Equal(10, len(entries))
+	assert.Equal("happycat", entries[0].Sys.ID)
+	assert.Equal("cat", entries[0].Sys.ContentType.Sys.ID)
+	assert.Equal("Happy Cat", entries[0].Fields["name"])
+}
+
+func TestCollectionToAsset(t *testing.T) {
+	assert := assert.New(t)
+
+	col, err := collectionFromTestData("spaces-id1-assets.json")
+	assert.Nil(err)
+
+	assets := col.ToAsset()
+	assert.Equal(5, len(assets))
+	assert.Equal("1x0xpXu4pSGS4OukSyWGUK", assets[0].Sys.ID)
+	assert.Equal("Doge", assets[0].Fields.Title)
+	assert.Equal("doge.jpg", assets[0].Fields.File.Name)
+	assert.Equal("3HNzx9gvJScKku4UmcekYw", assets[1].Sys.ID)
+}
+
+func TestCollectionToLocale(t *testing.T) {
+	assert := assert.New(t)
+
+	col, err := collectionFromTestData("locales.json")
+	assert.Nil(err)
+
+	locales := col.ToLocale()
+	assert.Equal(1, len(locales))
+	assert.Equal("34N35DoyUQAtaKwWTgZs34", locales[0].Sys.ID)
+}
+
+func TestCollectionToWebhook(t *testing.T) {
+	assert := assert.New(t)
+
+	col := NewCollection(&CollectionOptions{})
+	err := json.Unmarshal([]byte(`{"total": 1, "items": [`+readTestData("webhook.json")+`]}`), col)
+	assert.Nil(err)
+
+	webhooks := col.ToWebhook()
+	assert.Equal(1, len(webhooks))
+	assert.Equal("webhook-name", webhooks[0].Name)
+	assert.Equal(2, len(webhooks[0].Headers))
+}
+
+func TestCollectionOf(t *testing.T) {
+	assert := assert.New(t)
+
+	col, err := collectionFromTestData("spaces-id1-entries.json")
+	assert.Nil(err)
+
+	entries, err := CollectionOf[*Entry](col)
+	assert.Nil(err)
+	assert.Equal(10, len(entries))
+	assert.Equal("happycat", entries[0].Sys.ID)
+	assert.Equal(col.ToEntry(), entries)
+
+	col, err = collectionFromTestData("spaces-id1-assets.json")
+	assert.Nil(err)
+
+	assets, err := CollectionOf[*Asset](col)
+	assert.Nil(err)
+	assert.Equal(5, len(assets))
+	assert.Equal("Doge", assets[0].Fields.Title)
+
+	// items which do not fit the model are reported
+	_, err = CollectionOf[*Locale](&Collection{Items: []interface{}{"not-a-locale"}})
+	assert.NotNil(err)
+}
+
+func TestCollectionOrderBy(t *testing.T) {
+	assert := assert.New(t)
+
+	col := NewCollection(&CollectionOptions{})
+	assert.Equal("order=-sys.createdAt", col.Query.String())
+
+	assert.Nil(col.OrderBy(SortUpdatedAt, true))
+	assert.Nil(col.OrderBy("fields.title", false))
+	assert.Equal("order=-sys.updatedAt%2Cfields.title", col.Query.String())
+
+	assert.NotNil(col.OrderBy("sys.created_at", false))
+	assert.NotNil(col.OrderBy("createdAt", false))
+	assert.NotNil(col.OrderBy("fields.", false))
+	assert.Equal("order=-sys.updatedAt%2Cfields.title", col.Query.String())
+}
+
+func TestCollectionErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	col, err := collectionFromTestData("entries-unresolved.json")
+	assert.Nil(err)
+	assert.Equal("Array", col.Sys.Type)
+
+	includes, ok := col.Includes.(map[string]interface{})
+	assert.True(ok)
+	assert.Equal(1, len(includes["Asset"].([]interface{})))
+
+	assert.Equal(1, len(col.Errors))
+	assert.Equal("notResolvable", col.Errors[0].Sys.ID)
+	assert.Equal(&CollectionErrorDetails{Type: "Link", LinkType: "Entry", ID: "happycat"}, col.Errors[0].Details)
+
+	col, err = collectionFromTestData("entries-page.json")
+	assert.Nil(err)
+	assert.Nil(col.Errors)
+}
+
+func TestCollectionLinksToEntry(t *testing.T) {
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("nyancat", r.URL.Query().Get("links_to_entry"))
+		fmt.Fprintln(w, readTestData("entries-page.json"))
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	cda := NewCDA(CDAToken, WithBaseURL(server.URL))
+
+	col := cda.Entries.List(spaceID)
+	col.LinksToEntry("nyancat")
+
+	_, err := col.Next()
+	assert.Nil(err)
+}
+
+func TestCollectionDecodesItemsOnce(t *testing.T) {
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, readTestData("entries-page.json"))
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	cma = NewCMA(CMAToken, WithBaseURL(server.URL))
+
+	col, err := cma.Entries.List(spaceID).Next()
+	assert.Nil(err)
+
+	// the same models are returned, the items are not decoded again
+	entries := col.ToEntry()
+	assert.Equal(2, len(entries))
+	assert.True(entries[0] == col.ToEntry()[0])
+
+	// other models are decoded separately
+	assets := col.ToAsset()
+	assert.Equal(2, len(assets))
+	assert.True(assets[0] == col.ToAsset()[0])
+
+	// the next page is decoded anew
+	_, err = col.Next()
+	assert.Nil(err)
+	assert.False(entries[0] == col.ToEntry()[0])
+	assert.Equal(entries[0].Sys.ID, col.ToEntry()[0].Sys.ID)
+}
+
+func TestCollectionLimitOutOfRange(t *testing.T) {
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s", r.URL.String())
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	cma = NewCMA(CMAToken, WithBaseURL(server.URL))
+
+	// out of range limits are rejected before the request is sent
+	col := cma.Entries.List(spaceID)
+	col.Query.Limit(1001)
+
+	_, err := col.Next()
+	assert.EqualError(err, "limit 1001 is out of range, the api returns at most 1000 items per page")
+
+	_, err = col.Fetch()
+	assert.NotNil(err)
+
+	col = NewCollection(&CollectionOptions{Limit: 5000})
+	assert.NotNil(col.Query.Err())
+}
+
+func TestCollectionIncludeOutOfRange(t *testing.T) {
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s", r.URL.String())
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	cda := NewCDA(CDAToken, WithBaseURL(server.URL))
+
+	// out of range include levels are rejected before the request is sent
+	col := cda.Entries.List(spaceID).ResolveLinks(true)
+	col.Query.Include(11)
+
+	_, err := col.Next()
+	assert.EqualError(err, "include 11 is out of range, the api includes at most 10 levels of links")
+}
@@ -0,0 +1,119 @@
+package contentful
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// CommentsService service
+type CommentsService service
+
+// Comment model, a comment on an entry
+type Comment struct {
+	Sys  *Sys   `json:"sys,omitempty"`
+	Body string `json:"body"`
+}
+
+// GetVersion returns entity version
+func (comment *Comment) GetVersion() int {
+	version := 1
+	if comment.Sys != nil {
+		version = comment.Sys.Version
+	}
+
+	return version
+}
+
+// List returns the comments collection of the entry
+func (service *CommentsService) List(spaceID, entryID string) *Collection {
+	path := service.c.envPath(spaceID, "/entries/%s/comments", entryID)
+	method := "GET"
+
+	req, err := service.c.newRequest(method, path, nil, nil)
+	if err != nil {
+		return &Collection{}
+	}
+
+	col := NewCollection(&CollectionOptions{})
+	col.c = service.c
+	col.req = req
+
+	return col
+}
+
+// Get returns a single comment of the entry
+func (service *CommentsService) Get(spaceID, entryID, commentID string) (*Comment, error) {
+	path := service.c.envPath(spaceID, "/entries/%s/comments/%s", entryID, commentID)
+	method := "GET"
+
+	req, err := service.c.newRequest(method, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var comment Comment
+	if err := service.c.do(req, &comment); err != nil {
+		return nil, err
+	}
+
+	return &comment, nil
+}
+
+// Create comments on the entry
+func (service *CommentsService) Create(spaceID, entryID string, comment *Comment) error {
+	bytesArray, err := json.Marshal(&Comment{Body: comment.Body})
+	if err != nil {
+		return err
+	}
+
+	path := service.c.envPath(spaceID, "/entries/%s/comments", entryID)
+	method := "POST"
+
+	req, err := service.c.newRequest(method, path, nil, bytes.NewReader(bytesArray))
+	if err != nil {
+		return err
+	}
+
+	return service.c.do(req, comment)
+}
+
+// Update changes the body of the comment
+func (service *CommentsService) Update(spaceID, entryID string, comment *Comment) error {
+	if comment.Sys == nil || comment.Sys.ID == "" {
+		return fmt.Errorf("updating a comment requires a comment id")
+	}
+
+	bytesArray, err := json.Marshal(&Comment{Body: comment.Body})
+	if err != nil {
+		return err
+	}
+
+	path := service.c.envPath(spaceID, "/entries/%s/comments/%s", entryID, comment.Sys.ID)
+	method := "PUT"
+
+	req, err := service.c.newRequest(method, path, nil, bytes.NewReader(bytesArray))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("X-Contentful-Version", strconv.Itoa(comment.GetVersion()))
+
+	return service.c.do(req, comment)
+}
+
+// Delete the comment
+func (service *CommentsService) Delete(spaceID, entryID string, comment *Comment) error {
+	path := service.c.envPath(spaceID, "/entries/%s/comments/%s", entryID, comment.Sys.ID)
+	method := "DELETE"
+
+	req, err := service.c.newRequest(method, path, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("X-Contentful-Version", strconv.Itoa(comment.GetVersion()))
+
+	return service.c.do(req, nil)
+}
@@ -0,0 +1,37 @@
+package contentful
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommentsServiceCreate(t *testing.T) {
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("POST", r.Method)
+		assert.Equal("/spaces/"+spaceID+"/environments/master/entries/nyancat/comments", r.URL.Path)
+
+		var payload map[string]interface{}
+		assert.Nil(json.NewDecoder(r.Body).Decode(&payload))
+		assert.Equal(map[string]interface{}{"body": "Needs a better picture"}, payload)
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintln(w, `{"sys": {"id": "comment-1", "type": "Comment", "version": 1}, "body": "Needs a better picture"}`)
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	cma = NewCMA(CMAToken, WithBaseURL(server.URL))
+
+	comment := &Comment{Body: "Needs a better picture"}
+	assert.Nil(cma.Comments.Create(spaceID, "nyancat", comment))
+	assert.Equal("comment-1", comment.Sys.ID)
+	assert.Equal(1, comment.Sys.Version)
+}
@@ -2,9 +2,13 @@ package contentful
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
+	"sort"
 	"strconv"
+	"strings"
 )
 
 // ContentTypesService service
@@ -46,20 +50,51 @@ const (
 
 	// FieldTypeObject content type field type for object data
 	FieldTypeObject = "Object"
+
+	// FieldTypeNumber content type field type for decimal number data
+	FieldTypeNumber = "Number"
+
+	// FieldTypeRichText content type field type for rich text data
+	FieldTypeRichText = "RichText"
 )
 
-// Field model
+const (
+	// LinkTypeEntry link type of links to entries
+	LinkTypeEntry = "Entry"
+
+	// LinkTypeAsset link type of links to assets
+	LinkTypeAsset = "Asset"
+)
+
+// Field model. Disabled and Omitted are always sent, so that setting them to
+// false re-enables a field on update.
 type Field struct {
-	ID          string              `json:"id,omitempty"`
-	Name        string              `json:"name"`
-	Type        string              `json:"type"`
-	LinkType    string              `json:"linkType,omitempty"`
-	Items       *FieldTypeArrayItem `json:"items,omitempty"`
-	Required    bool                `json:"required,omitempty"`
-	Localized   bool                `json:"localized,omitempty"`
-	Disabled    bool                `json:"disabled,omitempty"`
-	Omitted     bool                `json:"omitted,omitempty"`
-	Validations []FieldValidation   `json:"validations,omitempty"`
+	ID           string                 `json:"id,omitempty"`
+	Name         string                 `json:"name"`
+	Type         string                 `json:"type"`
+	LinkType     string                 `json:"linkType,omitempty"`
+	Items        *FieldTypeArrayItem    `json:"items,omitempty"`
+	Required     bool                   `json:"required,omitempty"`
+	Localized    bool                   `json:"localized,omitempty"`
+	Disabled     bool                   `json:"disabled"`
+	Omitted      bool                   `json:"omitted"`
+	Validations  []FieldValidation      `json:"validations,omitempty"`
+	DefaultValue map[string]interface{} `json:"defaultValue,omitempty"`
+}
+
+// Validate checks that a Link field, or an Array field of links, declares
+// whether it links to entries or to assets
+func (field *Field) Validate() error {
+	if field.Type == FieldTypeLink && field.LinkType != LinkTypeEntry && field.LinkType != LinkTypeAsset {
+		return fmt.Errorf("field %q of type Link requires a linkType of Entry or Asset, got %q", field.ID, field.LinkType)
+	}
+
+	if field.Type == FieldTypeArray && field.Items != nil && field.Items.Type == FieldTypeLink &&
+		field.Items.LinkType != LinkTypeEntry && field.Items.LinkType != LinkTypeAsset {
+		return fmt.Errorf("items of field %q of type Array of Link require a linkType of Entry or Asset, got %q", field.ID, field.Items.LinkType)
+	}
+
+	return nil
 }
 
 // UnmarshalJSON for custom json unmarshaling
@@ -124,6 +159,15 @@ func (field *Field) UnmarshalJSON(data []byte) error {
 		field.Validations = validations
 	}
 
+	if val, ok := payload["defaultValue"]; ok && val != nil {
+		defaultValue, ok := val.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("field %q has a default value which is not a locale map: %v", field.ID, val)
+		}
+
+		field.DefaultValue = defaultValue
+	}
+
 	return nil
 }
 
@@ -133,6 +177,8 @@ func ParseValidations(data []interface{}) (validations []FieldValidation, err er
 		var validation map[string]interface{}
 		var byteArray []byte
 
+		parsed := len(validations)
+
 		if validationStr, ok := value.(string); ok {
 			if err := json.Unmarshal([]byte(validationStr), &validation); err != nil {
 				return nil, err
@@ -239,6 +285,49 @@ func ParseValidations(data []interface{}) (validations []FieldValidation, err er
 
 			validations = append(validations, fieldValidationRegex)
 		}
+
+		if _, ok := validation["prohibitRegexp"]; ok {
+			var fieldValidationProhibitRegexp FieldValidationProhibitRegexp
+			if err := json.Unmarshal(byteArray, &fieldValidationProhibitRegexp); err != nil {
+				return nil, err
+			}
+
+			validations = append(validations, fieldValidationProhibitRegexp)
+		}
+
+		if _, ok := validation["enabledNodeTypes"]; ok {
+			var fieldValidationEnabledNodeTypes FieldValidationEnabledNodeTypes
+			if err := json.Unmarshal(byteArray, &fieldValidationEnabledNodeTypes); err != nil {
+				return nil, err
+			}
+
+			validations = append(validations, fieldValidationEnabledNodeTypes)
+		}
+
+		if _, ok := validation["enabledMarks"]; ok {
+			var fieldValidationEnabledMarks FieldValidationEnabledMarks
+			if err := json.Unmarshal(byteArray, &fieldValidationEnabledMarks); err != nil {
+				return nil, err
+			}
+
+			validations = append(validations, fieldValidationEnabledMarks)
+		}
+
+		if _, ok := validation["nodes"]; ok {
+			var fieldValidationRichTextNodes FieldValidationRichTextNodes
+			if err := json.Unmarshal(byteArray, &fieldValidationRichTextNodes); err != nil {
+				return nil, err
+			}
+
+			validations = append(validations, fieldValidationRichTextNodes)
+		}
+
+		// keep validations this package doesn't model so they survive an upsert
+		if len(validations) == parsed && validation != nil {
+			validations = append(validations, FieldValidationUnknown{
+				Raw: json.RawMessage(byteArray),
+			})
+		}
 	}
 
 	return validations, nil
@@ -271,7 +360,7 @@ func (item *FieldTypeArrayItem) UnmarshalJSON(data []byte) error {
 		item.Validations = validations
 	}
 
-	if val, ok := payload["linktype"]; ok {
+	if val, ok := payload["linkType"]; ok {
 		item.LinkType = val.(string)
 	}
 
@@ -290,7 +379,7 @@ func (ct *ContentType) GetVersion() int {
 
 // List return a content type collection
 func (service *ContentTypesService) List(spaceID string) *Collection {
-	path := fmt.Sprintf("/spaces/%s/content_types", spaceID)
+	path := service.c.envPath(spaceID, "/content_types")
 	method := "GET"
 
 	req, err := service.c.newRequest(method, path, nil, nil)
@@ -307,7 +396,17 @@ func (service *ContentTypesService) List(spaceID string) *Collection {
 
 // Get fetched a content type specified by `contentTypeID`
 func (service *ContentTypesService) Get(spaceID, contentTypeID string) (*ContentType, error) {
-	path := fmt.Sprintf("/spaces/%s/content_types/%s", spaceID, contentTypeID)
+	return service.get(context.Background(), spaceID, contentTypeID)
+}
+
+// GetContext returns a single content type like Get, the request is made with
+// ctx
+func (service *ContentTypesService) GetContext(ctx context.Context, spaceID, contentTypeID string) (*ContentType, error) {
+	return service.get(ctx, spaceID, contentTypeID)
+}
+
+func (service *ContentTypesService) get(ctx context.Context, spaceID, contentTypeID string) (*ContentType, error) {
+	path := service.c.envPath(spaceID, "/content_types/%s", contentTypeID)
 	method := "GET"
 
 	req, err := service.c.newRequest(method, path, nil, nil)
@@ -315,6 +414,8 @@ func (service *ContentTypesService) Get(spaceID, contentTypeID string) (*Content
 		return nil, err
 	}
 
+	req = withContext(req, ctx)
+
 	var ct ContentType
 	if err = service.c.do(req, &ct); err != nil {
 		return nil, err
@@ -323,21 +424,87 @@ func (service *ContentTypesService) Get(spaceID, contentTypeID string) (*Content
 	return &ct, nil
 }
 
+// GetIfModified returns the content type unless it still has the given
+// etag, and a nil content type with notModified set when the content type is
+// unchanged. The content type's current etag is returned as well, it is the
+// etag to pass to the next call, e.g. of a poller starting with an empty etag.
+func (service *ContentTypesService) GetIfModified(ctx context.Context, spaceID, contentTypeID, etag string) (ct *ContentType, currentETag string, notModified bool, err error) {
+	path := service.c.envPath(spaceID, "/content_types/%s", contentTypeID)
+
+	var fetched ContentType
+	currentETag, notModified, err = service.c.getIfModified(ctx, path, etag, &fetched)
+	if err != nil || notModified {
+		return nil, currentETag, notModified, err
+	}
+
+	return &fetched, currentETag, false, nil
+}
+
+// GetPublished fetches the published version of the content type specified
+// by `contentTypeID`
+func (service *ContentTypesService) GetPublished(ctx context.Context, spaceID, contentTypeID string) (*ContentType, error) {
+	path := service.c.envPath(spaceID, "/public/content_types")
+	method := "GET"
+
+	query := url.Values{}
+	query.Set("sys.id", contentTypeID)
+
+	req, err := service.c.newRequest(method, path, query, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var published struct {
+		Items []*ContentType `json:"items"`
+	}
+	if err = service.c.do(withContext(req, ctx), &published); err != nil {
+		return nil, err
+	}
+
+	if len(published.Items) == 0 {
+		return nil, fmt.Errorf("content type %q is not published", contentTypeID)
+	}
+
+	return published.Items[0], nil
+}
+
 // Upsert updates or creates a new content type
 func (service *ContentTypesService) Upsert(spaceID string, ct *ContentType) error {
+	return service.upsert(context.Background(), spaceID, ct)
+}
+
+// UpsertContext updates or creates the content type like Upsert, the request is
+// made with ctx
+func (service *ContentTypesService) UpsertContext(ctx context.Context, spaceID string, ct *ContentType) error {
+	return service.upsert(ctx, spaceID, ct)
+}
+
+func (service *ContentTypesService) upsert(ctx context.Context, spaceID string, ct *ContentType) error {
+	for _, field := range ct.Fields {
+		if err := field.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if service.c.validatesContentTypes() {
+		if errors := ct.lintDetails(); len(errors) > 0 {
+			return validationFailed(errors)
+		}
+	}
+
 	bytesArray, err := json.Marshal(ct)
 	if err != nil {
 		return err
 	}
 
-	var path string
+	var path apiPath
 	var method string
 
 	if ct.Sys != nil && ct.Sys.ID != "" {
-		path = fmt.Sprintf("/spaces/%s/content_types/%s", spaceID, ct.Sys.ID)
+		path = service.c.envPath(spaceID, "/content_types/%s", ct.Sys.ID)
 		method = "PUT"
 	} else {
-		path = fmt.Sprintf("/spaces/%s/content_types", spaceID)
+		path = service.c.envPath(spaceID, "/content_types")
 		method = "POST"
 	}
 
@@ -346,14 +513,24 @@ func (service *ContentTypesService) Upsert(spaceID string, ct *ContentType) erro
 		return err
 	}
 
-	req.Header.Set("X-Contentful-Version", strconv.Itoa(ct.GetVersion()))
+	setVersionHeader(req, ct.Sys)
 
-	return service.c.do(req, ct)
+	return service.c.do(withContext(req, ctx), ct)
 }
 
 // Delete the content_type
 func (service *ContentTypesService) Delete(spaceID string, ct *ContentType) error {
-	path := fmt.Sprintf("/spaces/%s/content_types/%s", spaceID, ct.Sys.ID)
+	return service.delete(context.Background(), spaceID, ct)
+}
+
+// DeleteContext deletes the content type like Delete, the request is made with
+// ctx
+func (service *ContentTypesService) DeleteContext(ctx context.Context, spaceID string, ct *ContentType) error {
+	return service.delete(ctx, spaceID, ct)
+}
+
+func (service *ContentTypesService) delete(ctx context.Context, spaceID string, ct *ContentType) error {
+	path := service.c.envPath(spaceID, "/content_types/%s", ct.Sys.ID)
 	method := "DELETE"
 
 	req, err := service.c.newRequest(method, path, nil, nil)
@@ -364,12 +541,22 @@ func (service *ContentTypesService) Delete(spaceID string, ct *ContentType) erro
 	version := strconv.Itoa(ct.Sys.Version)
 	req.Header.Set("X-Contentful-Version", version)
 
-	return service.c.do(req, nil)
+	return service.c.do(withContext(req, ctx), nil)
 }
 
 // Activate the contenttype, a.k.a publish
 func (service *ContentTypesService) Activate(spaceID string, ct *ContentType) error {
-	path := fmt.Sprintf("/spaces/%s/content_types/%s/published", spaceID, ct.Sys.ID)
+	return service.activate(context.Background(), spaceID, ct)
+}
+
+// ActivateContext activates the content type like Activate, the request is made
+// with ctx
+func (service *ContentTypesService) ActivateContext(ctx context.Context, spaceID string, ct *ContentType) error {
+	return service.activate(ctx, spaceID, ct)
+}
+
+func (service *ContentTypesService) activate(ctx context.Context, spaceID string, ct *ContentType) error {
+	path := service.c.envPath(spaceID, "/content_types/%s/published", ct.Sys.ID)
 	method := "PUT"
 
 	req, err := service.c.newRequest(method, path, nil, nil)
@@ -380,12 +567,139 @@ func (service *ContentTypesService) Activate(spaceID string, ct *ContentType) er
 	version := strconv.Itoa(ct.Sys.Version)
 	req.Header.Set("X-Contentful-Version", version)
 
-	return service.c.do(req, ct)
+	return service.c.do(withContext(req, ctx), ct)
+}
+
+// ActivateAll activates the content types, each one after the content types
+// it links to, as activating a content type linking to an inactive one
+// fails. Content types failing to activate are retried as long as others
+// get activated, to get past links the order can not follow, e.g. cycles.
+// The content types which could not be activated are returned as an
+// ActivateAllError.
+func (service *ContentTypesService) ActivateAll(ctx context.Context, spaceID string, cts []*ContentType) error {
+	pending := orderContentTypesByLinks(cts)
+	failed := map[string]error{}
+
+	for len(pending) > 0 {
+		retry := []*ContentType{}
+		failed = map[string]error{}
+
+		for _, ct := range pending {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			if err := service.activate(ctx, spaceID, ct); err != nil {
+				retry = append(retry, ct)
+				failed[ct.Sys.ID] = err
+			}
+		}
+
+		if len(retry) == len(pending) {
+			break
+		}
+
+		pending = retry
+	}
+
+	if len(failed) > 0 {
+		return ActivateAllError{Errors: failed}
+	}
+
+	return nil
+}
+
+// ActivateAllError holds the errors of the content types ActivateAll could
+// not activate, keyed by content type id
+type ActivateAllError struct {
+	Errors map[string]error
+}
+
+func (e ActivateAllError) Error() string {
+	ids := make([]string, 0, len(e.Errors))
+	for id := range e.Errors {
+		ids = append(ids, id)
+	}
+
+	sort.Strings(ids)
+
+	messages := make([]string, len(ids))
+	for i, id := range ids {
+		messages[i] = fmt.Sprintf("%s: %s", id, e.Errors[id])
+	}
+
+	return fmt.Sprintf("failed to activate %d content types: %s", len(ids), strings.Join(messages, "; "))
+}
+
+// orderContentTypesByLinks orders the content types so that content types
+// come after the content types they link to. Links in cycles and to content
+// types outside of the given ones are ignored.
+func orderContentTypesByLinks(cts []*ContentType) []*ContentType {
+	byID := map[string]*ContentType{}
+	for _, ct := range cts {
+		byID[ct.Sys.ID] = ct
+	}
+
+	ordered := []*ContentType{}
+	visited := map[string]bool{}
+	visiting := map[string]bool{}
+
+	var visit func(ct *ContentType)
+	visit = func(ct *ContentType) {
+		id := ct.Sys.ID
+		if visited[id] || visiting[id] {
+			return
+		}
+
+		visiting[id] = true
+
+		for _, target := range ct.linkedContentTypes() {
+			if linked, ok := byID[target]; ok {
+				visit(linked)
+			}
+		}
+
+		visiting[id] = false
+		visited[id] = true
+		ordered = append(ordered, ct)
+	}
+
+	for _, ct := range cts {
+		visit(ct)
+	}
+
+	return ordered
+}
+
+// linkedContentTypes returns the ids of the content types the link
+// validations of the fields restrict links to
+func (ct *ContentType) linkedContentTypes() []string {
+	ids := []string{}
+
+	for _, field := range ct.Fields {
+		validations := append([]FieldValidation{}, field.Validations...)
+		if field.Items != nil {
+			validations = append(validations, field.Items.Validations...)
+		}
+
+		for _, validation := range validations {
+			switch v := validation.(type) {
+			case FieldValidationLink:
+				ids = append(ids, v.LinkContentType...)
+			case *FieldValidationLink:
+				if v != nil {
+					ids = append(ids, v.LinkContentType...)
+				}
+			}
+		}
+	}
+
+	return ids
 }
 
 // Deactivate the contenttype, a.k.a unpublish
 func (service *ContentTypesService) Deactivate(spaceID string, ct *ContentType) error {
-	path := fmt.Sprintf("/spaces/%s/content_types/%s/published", spaceID, ct.Sys.ID)
+	path := service.c.envPath(spaceID, "/content_types/%s/published", ct.Sys.ID)
 	method := "DELETE"
 
 	req, err := service.c.newRequest(method, path, nil, nil)
@@ -398,3 +712,201 @@ func (service *ContentTypesService) Deactivate(spaceID string, ct *ContentType)
 
 	return service.c.do(req, ct)
 }
+
+// Validate checks the content type against the rules the api enforces
+// without saving it. The api offers no dry run for content types, so the
+// checks run locally; when the content type already exists, the stored
+// version is fetched to catch field type changes. Failed checks are returned
+// as a ValidationFailedError, the same as a rejected Upsert.
+func (service *ContentTypesService) Validate(ctx context.Context, spaceID string, ct *ContentType) error {
+	var current *ContentType
+
+	if ct.Sys != nil && ct.Sys.ID != "" {
+		var err error
+		current, err = service.get(ctx, spaceID, ct.Sys.ID)
+		if _, ok := err.(NotFoundError); err != nil && !ok {
+			return err
+		}
+	}
+
+	errors := ct.validate(current)
+	if len(errors) == 0 {
+		return nil
+	}
+
+	return validationFailed(errors)
+}
+
+// validationFailed returns a ValidationFailedError of the given failed checks
+func validationFailed(errors []*ErrorDetail) ValidationFailedError {
+	return ValidationFailedError{
+		APIError{
+			err: &ErrorResponse{
+				Sys: &Sys{
+					Type: "Error",
+					ID:   "ValidationFailed",
+				},
+				Message: "Validation error",
+				Details: &ErrorDetails{
+					Errors: errors,
+				},
+			},
+		},
+	}
+}
+
+// Lint checks the content type locally, without any request. Besides the rules
+// the api enforces on its own, it reports Link fields to entries which do
+// not restrict the content types they link to with a linkContentType
+// validation.
+func (ct *ContentType) Lint() []error {
+	errors := []error{}
+
+	for _, detail := range ct.lintDetails() {
+		path := []string{}
+		for _, segment := range detail.Path.([]interface{}) {
+			path = append(path, fmt.Sprintf("%v", segment))
+		}
+
+		errors = append(errors, fmt.Errorf("%s: %s", strings.Join(path, "."), detail.Details))
+	}
+
+	return errors
+}
+
+// lintDetails returns the failed checks of Lint
+func (ct *ContentType) lintDetails() []*ErrorDetail {
+	errors := ct.validate(nil)
+
+	for i, field := range ct.Fields {
+		validations := field.Validations
+		path := []interface{}{"fields", i, "validations"}
+
+		if field.Type == FieldTypeArray && field.Items != nil && field.Items.Type == FieldTypeLink {
+			validations = field.Items.Validations
+			field = &Field{ID: field.ID, Type: FieldTypeLink, LinkType: field.Items.LinkType}
+			path = []interface{}{"fields", i, "items", "validations"}
+		}
+
+		if field.Type != FieldTypeLink || field.LinkType != LinkTypeEntry || hasLinkContentType(validations) {
+			continue
+		}
+
+		errors = append(errors, &ErrorDetail{
+			Name:    "linkContentType",
+			Path:    path,
+			Details: fmt.Sprintf("Link field %q does not restrict the content types it links to", field.ID),
+		})
+	}
+
+	return errors
+}
+
+func hasLinkContentType(validations []FieldValidation) bool {
+	for _, validation := range validations {
+		switch v := validation.(type) {
+		case FieldValidationLink:
+			if len(v.LinkContentType) > 0 {
+				return true
+			}
+		case *FieldValidationLink:
+			if v != nil && len(v.LinkContentType) > 0 {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+var fieldTypes = map[string]bool{
+	FieldTypeText:     true,
+	FieldTypeSymbol:   true,
+	FieldTypeArray:    true,
+	FieldTypeLink:     true,
+	FieldTypeInteger:  true,
+	FieldTypeNumber:   true,
+	FieldTypeLocation: true,
+	FieldTypeBoolean:  true,
+	FieldTypeDate:     true,
+	FieldTypeObject:   true,
+	FieldTypeRichText: true,
+}
+
+func (ct *ContentType) validate(current *ContentType) []*ErrorDetail {
+	errors := []*ErrorDetail{}
+
+	fail := func(name, details string, path ...interface{}) {
+		errors = append(errors, &ErrorDetail{
+			Name:    name,
+			Path:    path,
+			Details: details,
+		})
+	}
+
+	if ct.Name == "" {
+		fail("required", "The property \"name\" is required here", "name")
+	}
+
+	currentTypes := map[string]string{}
+	if current != nil {
+		for _, field := range current.Fields {
+			currentTypes[field.ID] = field.Type
+		}
+	}
+
+	ids := map[string]bool{}
+	for i, field := range ct.Fields {
+		duplicate := ids[field.ID]
+		if field.ID == "" {
+			fail("required", "The property \"id\" is required here", "fields", i, "id")
+		} else if duplicate {
+			fail("uniqueFieldIds", fmt.Sprintf("Field id %q is used more than once", field.ID), "fields", i, "id")
+		}
+		ids[field.ID] = true
+
+		if field.Name == "" {
+			fail("required", "The property \"name\" is required here", "fields", i, "name")
+		}
+
+		if !fieldTypes[field.Type] {
+			fail("in", fmt.Sprintf("Value %q is not a field type", field.Type), "fields", i, "type")
+			continue
+		}
+
+		if currentType, ok := currentTypes[field.ID]; ok && !duplicate && currentType != field.Type {
+			fail("fieldTypeChanged", fmt.Sprintf("Field type can not be changed from %s to %s", currentType, field.Type), "fields", i, "type")
+		}
+
+		switch field.Type {
+		case FieldTypeLink:
+			if field.LinkType != LinkTypeEntry && field.LinkType != LinkTypeAsset {
+				fail("in", "Value must be one of expected values: Entry, Asset", "fields", i, "linkType")
+			}
+		case FieldTypeArray:
+			if field.Items == nil {
+				fail("required", "The property \"items\" is required here", "fields", i, "items")
+			} else if field.Items.Type != FieldTypeSymbol && field.Items.Type != FieldTypeLink {
+				fail("in", "Value must be one of expected values: Symbol, Link", "fields", i, "items", "type")
+			} else if field.Items.Type == FieldTypeLink && field.Items.LinkType != LinkTypeEntry && field.Items.LinkType != LinkTypeAsset {
+				fail("in", "Value must be one of expected values: Entry, Asset", "fields", i, "items", "linkType")
+			}
+		}
+	}
+
+	if ct.DisplayField != "" {
+		valid := false
+		for _, field := range ct.Fields {
+			if field.ID == ct.DisplayField {
+				valid = field.Type == FieldTypeSymbol || field.Type == FieldTypeText
+				break
+			}
+		}
+
+		if !valid {
+			fail("displayField", fmt.Sprintf("Display field %q must be a Symbol or Text field of the content type", ct.DisplayField), "displayField")
+		}
+	}
+
+	return errors
+}
@@ -0,0 +1,42 @@
+package contentful
+
+import "sync"
+
+// contentTypeCache holds the content types fetched to look up field types,
+// keyed by space, environment and content type id
+type contentTypeCache struct {
+	mu    sync.RWMutex
+	items map[string]*ContentType
+}
+
+func newContentTypeCache() *contentTypeCache {
+	return &contentTypeCache{
+		items: map[string]*ContentType{},
+	}
+}
+
+func contentTypeCacheKey(spaceID, environment, contentTypeID string) string {
+	return spaceID + "/" + environment + "/" + contentTypeID
+}
+
+func (cache *contentTypeCache) get(key string) (*ContentType, bool) {
+	cache.mu.RLock()
+	defer cache.mu.RUnlock()
+
+	ct, ok := cache.items[key]
+	return ct, ok
+}
+
+func (cache *contentTypeCache) set(key string, ct *ContentType) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	cache.items[key] = ct
+}
+
+func (cache *contentTypeCache) clear() {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	cache.items = map[string]*ContentType{}
+}
@@ -0,0 +1,191 @@
+package contentful
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// ContentTypeDiff model, the differences between two versions of a content
+// type
+type ContentTypeDiff struct {
+	// Changes lists the changed content type attributes: name, description
+	// and displayField
+	Changes  []string
+	Added    []*Field
+	Removed  []*Field
+	Modified []*FieldDiff
+}
+
+// FieldDiff model, the differences between two versions of a field
+type FieldDiff struct {
+	ID  string
+	Old *Field
+	New *Field
+
+	// Changes lists the changed field attributes by their json name, e.g.
+	// type, required or validations
+	Changes []string
+}
+
+// HasChanges reports whether the content types differ
+func (diff ContentTypeDiff) HasChanges() bool {
+	return len(diff.Changes) > 0 || len(diff.Added) > 0 || len(diff.Removed) > 0 || len(diff.Modified) > 0
+}
+
+// HasChange reports whether the given attribute of the field changed
+func (diff *FieldDiff) HasChange(attribute string) bool {
+	for _, change := range diff.Changes {
+		if change == attribute {
+			return true
+		}
+	}
+
+	return false
+}
+
+// DiffContentTypes compares two versions of a content type, fields are
+// matched by their id. A nil content type is treated as one without fields.
+func DiffContentTypes(old, updated *ContentType) ContentTypeDiff {
+	diff := ContentTypeDiff{
+		Changes:  []string{},
+		Added:    []*Field{},
+		Removed:  []*Field{},
+		Modified: []*FieldDiff{},
+	}
+
+	if old == nil {
+		old = &ContentType{}
+	}
+
+	if updated == nil {
+		updated = &ContentType{}
+	}
+
+	if old.Name != updated.Name {
+		diff.Changes = append(diff.Changes, "name")
+	}
+
+	if old.Description != updated.Description {
+		diff.Changes = append(diff.Changes, "description")
+	}
+
+	if old.DisplayField != updated.DisplayField {
+		diff.Changes = append(diff.Changes, "displayField")
+	}
+
+	oldFields := map[string]*Field{}
+	for _, field := range old.Fields {
+		oldFields[field.ID] = field
+	}
+
+	newFields := map[string]*Field{}
+	for _, field := range updated.Fields {
+		newFields[field.ID] = field
+
+		oldField, ok := oldFields[field.ID]
+		if !ok {
+			diff.Added = append(diff.Added, field)
+			continue
+		}
+
+		if changes := diffFields(oldField, field); len(changes) > 0 {
+			diff.Modified = append(diff.Modified, &FieldDiff{
+				ID:      field.ID,
+				Old:     oldField,
+				New:     field,
+				Changes: changes,
+			})
+		}
+	}
+
+	for _, field := range old.Fields {
+		if _, ok := newFields[field.ID]; !ok {
+			diff.Removed = append(diff.Removed, field)
+		}
+	}
+
+	return diff
+}
+
+func diffFields(old, updated *Field) []string {
+	changes := []string{}
+
+	if old.Name != updated.Name {
+		changes = append(changes, "name")
+	}
+
+	if old.Type != updated.Type {
+		changes = append(changes, "type")
+	}
+
+	if old.LinkType != updated.LinkType {
+		changes = append(changes, "linkType")
+	}
+
+	if !jsonEqual(old.Items, updated.Items) {
+		changes = append(changes, "items")
+	}
+
+	if old.Required != updated.Required {
+		changes = append(changes, "required")
+	}
+
+	if old.Localized != updated.Localized {
+		changes = append(changes, "localized")
+	}
+
+	if old.Disabled != updated.Disabled {
+		changes = append(changes, "disabled")
+	}
+
+	if old.Omitted != updated.Omitted {
+		changes = append(changes, "omitted")
+	}
+
+	if !jsonEqual(old.Validations, updated.Validations) {
+		changes = append(changes, "validations")
+	}
+
+	if !jsonEqual(old.DefaultValue, updated.DefaultValue) {
+		changes = append(changes, "defaultValue")
+	}
+
+	return changes
+}
+
+// jsonEqual compares values by their json representation, which ignores the
+// differences between pointer and value validations and nil and empty slices
+func jsonEqual(a, b interface{}) bool {
+	var decodedA, decodedB interface{}
+
+	byteArray, err := json.Marshal(a)
+	if err != nil {
+		return false
+	}
+	json.Unmarshal(byteArray, &decodedA)
+
+	byteArray, err = json.Marshal(b)
+	if err != nil {
+		return false
+	}
+	json.Unmarshal(byteArray, &decodedB)
+
+	if isEmptyJSON(decodedA) && isEmptyJSON(decodedB) {
+		return true
+	}
+
+	return reflect.DeepEqual(decodedA, decodedB)
+}
+
+func isEmptyJSON(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+
+	return false
+}
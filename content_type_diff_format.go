@@ -0,0 +1,134 @@
+package contentful
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	colorReset  = "\x1b[0m"
+	colorRed    = "\x1b[31m"
+	colorGreen  = "\x1b[32m"
+	colorYellow = "\x1b[33m"
+)
+
+// FormatDiff renders the diff as a human readable summary for e.g. CI logs,
+// one change per line:
+//
+//	fmt.Print(FormatDiff(diff))
+//	// + added field `slug` (Symbol)
+//	// ~ changed `title` type Symbol→Text, required
+//	// - removed `body`
+func FormatDiff(d ContentTypeDiff) string {
+	return formatDiff(d, false)
+}
+
+// FormatDiffColor renders the diff like FormatDiff, with added lines in
+// green, changed lines in yellow and removed lines in red
+func FormatDiffColor(d ContentTypeDiff) string {
+	return formatDiff(d, true)
+}
+
+func formatDiff(d ContentTypeDiff, color bool) string {
+	if !d.HasChanges() {
+		return "no changes\n"
+	}
+
+	var b strings.Builder
+	line := func(c, format string, args ...interface{}) {
+		if color {
+			b.WriteString(c)
+		}
+		fmt.Fprintf(&b, format, args...)
+		if color {
+			b.WriteString(colorReset)
+		}
+		b.WriteString("\n")
+	}
+
+	for _, change := range d.Changes {
+		line(colorYellow, "~ changed content type %s", change)
+	}
+
+	for _, field := range d.Added {
+		line(colorGreen, "+ added field `%s` (%s)", field.ID, describeFieldType(field))
+	}
+
+	for _, field := range d.Modified {
+		line(colorYellow, "~ changed `%s` %s", field.ID, strings.Join(describeFieldChanges(field), ", "))
+	}
+
+	for _, field := range d.Removed {
+		line(colorRed, "- removed `%s`", field.ID)
+	}
+
+	return b.String()
+}
+
+// describeFieldChanges lists the changed attributes, a type change is
+// rendered with the old and new type. Changed items of the same type, e.g.
+// only their validations, are listed as any other attribute.
+func describeFieldChanges(diff *FieldDiff) []string {
+	changes := []string{}
+	oldType, newType := describeFieldType(diff.Old), describeFieldType(diff.New)
+	retyped := oldType != newType
+	reported := false
+
+	for _, change := range diff.Changes {
+		switch {
+		case retyped && (change == "type" || change == "linkType" || change == "items"):
+			if !reported {
+				reported = true
+				changes = append(changes, fmt.Sprintf("type %s→%s", oldType, newType))
+			}
+		case change == "items" && itemValidationsChangedOnly(diff.Old.Items, diff.New.Items):
+			changes = append(changes, "items.validations")
+		default:
+			changes = append(changes, change)
+		}
+	}
+
+	return changes
+}
+
+// itemValidationsChangedOnly reports whether the items differ in their
+// validations only
+func itemValidationsChangedOnly(old, updated *FieldTypeArrayItem) bool {
+	if old == nil || updated == nil {
+		return false
+	}
+
+	oldItems, updatedItems := *old, *updated
+	oldItems.Validations, updatedItems.Validations = nil, nil
+
+	return jsonEqual(&oldItems, &updatedItems)
+}
+
+// describeFieldType returns the field type including the link and item types,
+// e.g. "Array of Link to Entry"
+func describeFieldType(field *Field) string {
+	switch field.Type {
+	case FieldTypeLink:
+		return describeLinkType(field.LinkType)
+	case FieldTypeArray:
+		if field.Items == nil {
+			return field.Type
+		}
+
+		if field.Items.Type == FieldTypeLink {
+			return "Array of " + describeLinkType(field.Items.LinkType)
+		}
+
+		return "Array of " + field.Items.Type
+	}
+
+	return field.Type
+}
+
+func describeLinkType(linkType string) string {
+	if linkType == "" {
+		return FieldTypeLink
+	}
+
+	return FieldTypeLink + " to " + linkType
+}
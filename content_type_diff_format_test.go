@@ -0,0 +1,76 @@
+package contentful
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func formatDiffFixture() ContentTypeDiff {
+	old := &ContentType{
+		Name:         "article",
+		DisplayField: "title",
+		Fields: []*Field{
+			{ID: "title", Name: "Title", Type: FieldTypeSymbol, Required: true},
+			{ID: "body", Name: "Body", Type: FieldTypeText},
+			{ID: "author", Name: "Author", Type: FieldTypeLink, LinkType: LinkTypeEntry},
+			{ID: "tags", Name: "Tags", Type: FieldTypeArray, Items: &FieldTypeArrayItem{Type: FieldTypeSymbol}},
+		},
+	}
+
+	updated := &ContentType{
+		Name:         "Article",
+		DisplayField: "title",
+		Fields: []*Field{
+			{ID: "title", Name: "Title", Type: FieldTypeText},
+			{ID: "author", Name: "Author", Type: FieldTypeLink, LinkType: LinkTypeEntry, Localized: true},
+			{ID: "tags", Name: "Tags", Type: FieldTypeArray, Items: &FieldTypeArrayItem{Type: FieldTypeLink, LinkType: LinkTypeEntry}},
+			{ID: "slug", Name: "Slug", Type: FieldTypeSymbol},
+			{ID: "images", Name: "Images", Type: FieldTypeArray, Items: &FieldTypeArrayItem{Type: FieldTypeLink, LinkType: LinkTypeAsset}},
+		},
+	}
+
+	return DiffContentTypes(old, updated)
+}
+
+func TestFormatDiff(t *testing.T) {
+	assert := assert.New(t)
+
+	golden, err := os.ReadFile("testdata/content_type_diff.golden")
+	assert.Nil(err)
+	assert.Equal(string(golden), FormatDiff(formatDiffFixture()))
+}
+
+func TestFormatDiffColor(t *testing.T) {
+	assert := assert.New(t)
+
+	golden, err := os.ReadFile("testdata/content_type_diff_color.golden")
+	assert.Nil(err)
+	assert.Equal(string(golden), FormatDiffColor(formatDiffFixture()))
+}
+
+func TestFormatDiffNoChanges(t *testing.T) {
+	ct := &ContentType{Name: "article"}
+	assert.Equal(t, "no changes\n", FormatDiff(DiffContentTypes(ct, ct)))
+}
+
+func TestFormatDiffItemValidations(t *testing.T) {
+	assert := assert.New(t)
+
+	old := &ContentType{Fields: []*Field{
+		{ID: "tags", Name: "Tags", Type: FieldTypeArray, Items: &FieldTypeArrayItem{Type: FieldTypeSymbol}},
+	}}
+	updated := &ContentType{Fields: []*Field{
+		{ID: "tags", Name: "Tags", Type: FieldTypeArray, Items: &FieldTypeArrayItem{
+			Type:        FieldTypeSymbol,
+			Validations: []FieldValidation{FieldValidationSize{Size: &MinMax{Max: 10}}},
+		}},
+	}}
+
+	assert.Equal("~ changed `tags` items.validations\n", FormatDiff(DiffContentTypes(old, updated)))
+
+	// items of another type are still a type change
+	updated.Fields[0].Items = &FieldTypeArrayItem{Type: FieldTypeLink, LinkType: LinkTypeAsset}
+	assert.Equal("~ changed `tags` type Array of Symbol→Array of Link to Asset\n", FormatDiff(DiffContentTypes(old, updated)))
+}
@@ -0,0 +1,150 @@
+package contentful
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffContentTypes(t *testing.T) {
+	base := func() *ContentType {
+		return &ContentType{
+			Name:         "article",
+			DisplayField: "title",
+			Fields: []*Field{
+				&Field{ID: "title", Name: "Title", Type: FieldTypeSymbol, Required: true},
+				&Field{
+					ID:   "body",
+					Name: "Body",
+					Type: FieldTypeText,
+					Validations: []FieldValidation{
+						&FieldValidationSize{Size: &MinMax{Max: 1000}},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		change   func(ct *ContentType)
+		changes  []string
+		added    []string
+		removed  []string
+		modified map[string][]string
+	}{
+		{
+			name:   "unchanged",
+			change: func(ct *ContentType) {},
+		},
+		{
+			name: "added field",
+			change: func(ct *ContentType) {
+				ct.Fields = append(ct.Fields, &Field{ID: "slug", Name: "Slug", Type: FieldTypeSymbol})
+			},
+			added: []string{"slug"},
+		},
+		{
+			name: "removed field",
+			change: func(ct *ContentType) {
+				ct.Fields = ct.Fields[:1]
+			},
+			removed: []string{"body"},
+		},
+		{
+			name: "retyped field",
+			change: func(ct *ContentType) {
+				ct.Fields[0].Type = FieldTypeText
+				ct.Fields[0].Required = false
+			},
+			modified: map[string][]string{"title": []string{"type", "required"}},
+		},
+		{
+			name: "changed validation",
+			change: func(ct *ContentType) {
+				ct.Fields[1].Validations = []FieldValidation{
+					&FieldValidationSize{Size: &MinMax{Max: 2000}},
+				}
+			},
+			modified: map[string][]string{"body": []string{"validations"}},
+		},
+		{
+			name: "equal validation values",
+			change: func(ct *ContentType) {
+				ct.Fields[1].Validations = []FieldValidation{
+					FieldValidationSize{Size: &MinMax{Max: 1000}},
+				}
+			},
+		},
+		{
+			name: "renamed content type",
+			change: func(ct *ContentType) {
+				ct.Name = "post"
+				ct.DisplayField = "body"
+			},
+			changes: []string{"name", "displayField"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			updated := base()
+			test.change(updated)
+			diff := DiffContentTypes(base(), updated)
+
+			ids := func(fields []*Field) []string {
+				result := []string{}
+				for _, field := range fields {
+					result = append(result, field.ID)
+				}
+				return result
+			}
+
+			modified := map[string][]string{}
+			for _, field := range diff.Modified {
+				modified[field.ID] = field.Changes
+			}
+
+			expectChanges := test.changes
+			if expectChanges == nil {
+				expectChanges = []string{}
+			}
+			expectAdded := test.added
+			if expectAdded == nil {
+				expectAdded = []string{}
+			}
+			expectRemoved := test.removed
+			if expectRemoved == nil {
+				expectRemoved = []string{}
+			}
+			expectModified := test.modified
+			if expectModified == nil {
+				expectModified = map[string][]string{}
+			}
+
+			assert.Equal(expectChanges, diff.Changes)
+			assert.Equal(expectAdded, ids(diff.Added))
+			assert.Equal(expectRemoved, ids(diff.Removed))
+			assert.Equal(expectModified, modified)
+			assert.Equal(test.name != "unchanged" && test.name != "equal validation values", diff.HasChanges())
+		})
+	}
+}
+
+func TestDiffContentTypesNil(t *testing.T) {
+	assert := assert.New(t)
+
+	ct := &ContentType{
+		Name:   "article",
+		Fields: []*Field{&Field{ID: "title", Name: "Title", Type: FieldTypeSymbol}},
+	}
+
+	diff := DiffContentTypes(nil, ct)
+	assert.Equal([]string{"name"}, diff.Changes)
+	assert.Equal(1, len(diff.Added))
+
+	diff = DiffContentTypes(ct, nil)
+	assert.Equal(1, len(diff.Removed))
+}
@@ -11,6 +11,7 @@ type FieldValidation interface{}
 // FieldValidationLink model
 type FieldValidationLink struct {
 	LinkContentType []string `json:"linkContentType,omitempty"`
+	ErrorMessage    string   `json:"message,omitempty"`
 }
 
 const (
@@ -53,7 +54,8 @@ const (
 
 // FieldValidationMimeType model
 type FieldValidationMimeType struct {
-	MimeTypes []string `json:"linkMimetypeGroup,omitempty"`
+	MimeTypes    []string `json:"linkMimetypeGroup,omitempty"`
+	ErrorMessage string   `json:"message,omitempty"`
 }
 
 // MinMax model
@@ -76,7 +78,7 @@ type FieldValidationDimension struct {
 }
 
 // MarshalJSON for custom json marshaling
-func (v *FieldValidationDimension) MarshalJSON() ([]byte, error) {
+func (v FieldValidationDimension) MarshalJSON() ([]byte, error) {
 	type dimension struct {
 		Width  *MinMax `json:"width,omitempty"`
 		Height *MinMax `json:"height,omitempty"`
@@ -110,7 +112,7 @@ func (v *FieldValidationDimension) UnmarshalJSON(data []byte) error {
 			v.Width.Min = min
 		}
 
-		if max, ok := width["min"].(float64); ok {
+		if max, ok := width["max"].(float64); ok {
 			v.Width.Max = max
 		}
 	}
@@ -148,7 +150,7 @@ type FieldValidationUnique struct {
 // FieldValidationPredefinedValues model
 type FieldValidationPredefinedValues struct {
 	In           []interface{} `json:"in,omitempty"`
-	ErrorMessage string        `json:"message"`
+	ErrorMessage string        `json:"message,omitempty"`
 }
 
 // FieldValidationRange model
@@ -163,23 +165,36 @@ type FieldValidationDate struct {
 	ErrorMessage string      `json:"message,omitempty"`
 }
 
+// fieldValidationDateLayout is the layout of the dates of a date range
+const fieldValidationDateLayout = "2006-01-02T15:04:05"
+
 // MarshalJSON for custom json marshaling
-func (v *FieldValidationDate) MarshalJSON() ([]byte, error) {
+func (v FieldValidationDate) MarshalJSON() ([]byte, error) {
 	type dateRange struct {
 		Min string `json:"min,omitempty"`
 		Max string `json:"max,omitempty"`
 	}
 
-	return json.Marshal(&struct {
+	payload := struct {
 		DateRange *dateRange `json:"dateRange,omitempty"`
 		Message   string     `json:"message,omitempty"`
 	}{
-		DateRange: &dateRange{
-			Min: v.Range.Max.Format("2006-01-02T03:04:05"),
-			Max: v.Range.Max.Format("2006-01-02T03:04:05"),
-		},
 		Message: v.ErrorMessage,
-	})
+	}
+
+	if v.Range != nil {
+		payload.DateRange = &dateRange{}
+
+		if !v.Range.Min.IsZero() {
+			payload.DateRange.Min = v.Range.Min.Format(fieldValidationDateLayout)
+		}
+
+		if !v.Range.Max.IsZero() {
+			payload.DateRange.Max = v.Range.Max.Format(fieldValidationDateLayout)
+		}
+	}
+
+	return json.Marshal(&payload)
 }
 
 // UnmarshalJSON for custom json unmarshaling
@@ -189,26 +204,26 @@ func (v *FieldValidationDate) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
-	dateRangeData := payload["dateRange"].(map[string]interface{})
+	if dateRangeData, ok := payload["dateRange"].(map[string]interface{}); ok {
+		v.Range = &DateMinMax{}
 
-	v.Range = &DateMinMax{}
+		if min, ok := dateRangeData["min"].(string); ok {
+			minDate, err := time.Parse(fieldValidationDateLayout, min)
+			if err != nil {
+				return err
+			}
 
-	if min, ok := dateRangeData["min"].(string); ok {
-		minDate, err := time.Parse("2006-01-02T03:04:05", min)
-		if err != nil {
-			return err
+			v.Range.Min = minDate
 		}
 
-		v.Range.Min = minDate
-	}
+		if max, ok := dateRangeData["max"].(string); ok {
+			maxDate, err := time.Parse(fieldValidationDateLayout, max)
+			if err != nil {
+				return err
+			}
 
-	if max, ok := dateRangeData["max"].(string); ok {
-		maxDate, err := time.Parse("2006-01-02T03:04:05", max)
-		if err != nil {
-			return err
+			v.Range.Max = maxDate
 		}
-
-		v.Range.Max = maxDate
 	}
 
 	if val, ok := payload["message"].(string); ok {
@@ -261,3 +276,102 @@ type FieldValidationRegex struct {
 	Regex        *Regex `json:"regexp,omitempty"`
 	ErrorMessage string `json:"message,omitempty"`
 }
+
+// FieldValidationProhibitRegexp model
+type FieldValidationProhibitRegexp struct {
+	Pattern      string `json:"pattern,omitempty"`
+	Flags        string `json:"flags,omitempty"`
+	ErrorMessage string `json:"message,omitempty"`
+}
+
+// MarshalJSON for custom json marshaling
+func (v FieldValidationProhibitRegexp) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&struct {
+		ProhibitRegexp *Regex `json:"prohibitRegexp,omitempty"`
+		Message        string `json:"message,omitempty"`
+	}{
+		ProhibitRegexp: &Regex{
+			Pattern: v.Pattern,
+			Flags:   v.Flags,
+		},
+		Message: v.ErrorMessage,
+	})
+}
+
+// UnmarshalJSON for custom json unmarshaling
+func (v *FieldValidationProhibitRegexp) UnmarshalJSON(data []byte) error {
+	var payload struct {
+		ProhibitRegexp *Regex `json:"prohibitRegexp"`
+		Message        string `json:"message"`
+	}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return err
+	}
+
+	if payload.ProhibitRegexp != nil {
+		v.Pattern = payload.ProhibitRegexp.Pattern
+		v.Flags = payload.ProhibitRegexp.Flags
+	}
+
+	v.ErrorMessage = payload.Message
+
+	return nil
+}
+
+// FieldValidationUnknown holds a validation which is not modeled by this
+// package. The raw json object is kept as is and sent back on marshaling.
+type FieldValidationUnknown struct {
+	Raw json.RawMessage
+}
+
+// MarshalJSON for custom json marshaling
+func (v FieldValidationUnknown) MarshalJSON() ([]byte, error) {
+	return v.Raw, nil
+}
+
+// UnmarshalJSON for custom json unmarshaling
+func (v *FieldValidationUnknown) UnmarshalJSON(data []byte) error {
+	v.Raw = append(json.RawMessage{}, data...)
+	return nil
+}
+
+// FieldValidationEnabledNodeTypes model
+type FieldValidationEnabledNodeTypes struct {
+	NodeTypes    []string `json:"enabledNodeTypes,omitempty"`
+	ErrorMessage string   `json:"message,omitempty"`
+}
+
+// FieldValidationEnabledMarks model
+type FieldValidationEnabledMarks struct {
+	Marks        []string `json:"enabledMarks,omitempty"`
+	ErrorMessage string   `json:"message,omitempty"`
+}
+
+// FieldValidationRichTextNodes model, validations of the embedded or linked
+// nodes of a rich text field keyed by node type, e.g. "entry-hyperlink"
+type FieldValidationRichTextNodes struct {
+	Nodes map[string][]FieldValidation `json:"nodes"`
+}
+
+// UnmarshalJSON for custom json unmarshaling
+func (v *FieldValidationRichTextNodes) UnmarshalJSON(data []byte) error {
+	var payload struct {
+		Nodes map[string][]interface{} `json:"nodes"`
+	}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return err
+	}
+
+	v.Nodes = map[string][]FieldValidation{}
+
+	for nodeType, nodeValidations := range payload.Nodes {
+		validations, err := ParseValidations(nodeValidations)
+		if err != nil {
+			return err
+		}
+
+		v.Nodes[nodeType] = validations
+	}
+
+	return nil
+}
@@ -135,9 +135,9 @@ func TestFieldValidationDate(t *testing.T) {
 	var err error
 	assert := assert.New(t)
 
-	layout := "2006-01-02T03:04:05"
-	min := time.Now()
-	max := time.Now()
+	layout := "2006-01-02T15:04:05"
+	min := time.Date(2017, 1, 1, 9, 30, 0, 0, time.UTC)
+	max := time.Date(2018, 1, 1, 15, 0, 0, 0, time.UTC)
 
 	minStr := min.Format(layout)
 	maxStr := max.Format(layout)
@@ -159,4 +159,145 @@ func TestFieldValidationDate(t *testing.T) {
 	assert.Equal(minStr, validationCheck.Range.Min.Format(layout))
 	assert.Equal(maxStr, validationCheck.Range.Max.Format(layout))
 	assert.Equal("error message", validationCheck.ErrorMessage)
+	assert.Equal(15, validationCheck.Range.Max.Hour())
+}
+
+func TestFieldValidationDateMessageOnly(t *testing.T) {
+	var err error
+	assert := assert.New(t)
+
+	validation := FieldValidationDate{
+		ErrorMessage: "error message",
+	}
+	data, err := json.Marshal(validation)
+	assert.Nil(err)
+	assert.Equal("{\"message\":\"error message\"}", string(data))
+
+	var validationCheck FieldValidationDate
+	err = json.Unmarshal(data, &validationCheck)
+	assert.Nil(err)
+	assert.Nil(validationCheck.Range)
+	assert.Equal("error message", validationCheck.ErrorMessage)
+}
+
+func TestFieldValidationDateMinOnly(t *testing.T) {
+	var err error
+	assert := assert.New(t)
+
+	validation := FieldValidationDate{
+		Range: &DateMinMax{
+			Min: time.Date(2017, 6, 1, 15, 0, 0, 0, time.UTC),
+		},
+	}
+	data, err := json.Marshal(validation)
+	assert.Nil(err)
+	assert.Equal("{\"dateRange\":{\"min\":\"2017-06-01T15:00:00\"}}", string(data))
+
+	var validationCheck FieldValidationDate
+	err = json.Unmarshal(data, &validationCheck)
+	assert.Nil(err)
+	assert.Equal(validation.Range.Min, validationCheck.Range.Min)
+	assert.True(validationCheck.Range.Max.IsZero())
+}
+
+func TestFieldValidationErrorMessages(t *testing.T) {
+	assert := assert.New(t)
+
+	validations := []FieldValidation{
+		&FieldValidationLink{
+			LinkContentType: []string{"test"},
+			ErrorMessage:    "link error message",
+		},
+		&FieldValidationMimeType{
+			MimeTypes:    []string{MimeTypeImage},
+			ErrorMessage: "mime type error message",
+		},
+		&FieldValidationDimension{
+			Width:        &MinMax{Min: 10, Max: 20},
+			ErrorMessage: "dimension error message",
+		},
+		&FieldValidationFileSize{
+			Size:         &MinMax{Max: 1024},
+			ErrorMessage: "file size error message",
+		},
+		&FieldValidationPredefinedValues{
+			In:           []interface{}{"a", "b"},
+			ErrorMessage: "predefined values error message",
+		},
+		&FieldValidationRange{
+			Range:        &MinMax{Min: 1},
+			ErrorMessage: "range error message",
+		},
+		&FieldValidationDate{
+			Range: &DateMinMax{
+				Min: time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC),
+				Max: time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+			ErrorMessage: "date error message",
+		},
+		&FieldValidationSize{
+			Size:         &MinMax{Max: 5},
+			ErrorMessage: "size error message",
+		},
+		&FieldValidationRegex{
+			Regex:        &Regex{Pattern: FieldValidationRegexPatternEmail},
+			ErrorMessage: "regex error message",
+		},
+	}
+
+	data, err := json.Marshal(validations)
+	assert.Nil(err)
+
+	var payload []interface{}
+	err = json.Unmarshal(data, &payload)
+	assert.Nil(err)
+
+	parsed, err := ParseValidations(payload)
+	assert.Nil(err)
+	assert.Equal(len(validations), len(parsed))
+
+	assert.Equal("link error message", parsed[0].(FieldValidationLink).ErrorMessage)
+	assert.Equal("mime type error message", parsed[1].(FieldValidationMimeType).ErrorMessage)
+	assert.Equal("dimension error message", parsed[2].(FieldValidationDimension).ErrorMessage)
+	assert.Equal(float64(20), parsed[2].(FieldValidationDimension).Width.Max)
+	assert.Equal("file size error message", parsed[3].(FieldValidationFileSize).ErrorMessage)
+	assert.Equal("predefined values error message", parsed[4].(FieldValidationPredefinedValues).ErrorMessage)
+	assert.Equal("range error message", parsed[5].(FieldValidationRange).ErrorMessage)
+	assert.Equal("date error message", parsed[6].(FieldValidationDate).ErrorMessage)
+	assert.Equal(2017, parsed[6].(FieldValidationDate).Range.Min.Year())
+	assert.Equal("size error message", parsed[7].(FieldValidationSize).ErrorMessage)
+	assert.Equal("regex error message", parsed[8].(FieldValidationRegex).ErrorMessage)
+}
+
+func TestFieldValidationUnknown(t *testing.T) {
+	var err error
+	assert := assert.New(t)
+
+	data := []byte(`{"id":"f1","name":"f1","type":"Symbol","validations":[{"unique":true},{"futureValidation":{"level":3},"message":"future error message"}]}`)
+
+	var field Field
+	err = json.Unmarshal(data, &field)
+	assert.Nil(err)
+	assert.Equal(2, len(field.Validations))
+
+	_, ok := field.Validations[0].(FieldValidationUnique)
+	assert.True(ok)
+
+	unknown, ok := field.Validations[1].(FieldValidationUnknown)
+	assert.True(ok)
+	assert.JSONEq(`{"futureValidation":{"level":3},"message":"future error message"}`, string(unknown.Raw))
+
+	data, err = json.Marshal(&field)
+	assert.Nil(err)
+
+	var payload map[string]interface{}
+	err = json.Unmarshal(data, &payload)
+	assert.Nil(err)
+
+	validations := payload["validations"].([]interface{})
+	assert.Equal(2, len(validations))
+	assert.Equal(map[string]interface{}{
+		"futureValidation": map[string]interface{}{"level": float64(3)},
+		"message":          "future error message",
+	}, validations[1])
 }
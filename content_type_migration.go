@@ -0,0 +1,97 @@
+package contentful
+
+import "fmt"
+
+// The migration helpers change the content type in place and return it, save
+// it with ContentTypesService.Upsert and publish it with Activate. Entries
+// are not migrated, their values have to be copied by the caller.
+
+// AddField adds the field to the content type
+func (ct *ContentType) AddField(field *Field) (*ContentType, error) {
+	if field == nil || field.ID == "" {
+		return ct, fmt.Errorf("adding a field requires a field id")
+	}
+
+	if ct.field(field.ID) != nil {
+		return ct, fmt.Errorf("content type has a field %q already", field.ID)
+	}
+
+	ct.Fields = append(ct.Fields, field)
+
+	return ct, nil
+}
+
+// RenameField adds a copy of the field with the new id and omits the old
+// field, as field ids can not be changed. The old field is disabled in the
+// editor and left out of api responses, but it keeps its values: copy them to
+// the new field, then remove the old field in a later migration. The display
+// field is moved to the new field.
+func (ct *ContentType) RenameField(oldID, newID string) (*ContentType, error) {
+	old := ct.field(oldID)
+	if old == nil {
+		return ct, fmt.Errorf("content type has no field %q", oldID)
+	}
+
+	renamed := *old
+	renamed.ID = newID
+	renamed.Validations = append([]FieldValidation{}, old.Validations...)
+	if old.Items != nil {
+		items := *old.Items
+		renamed.Items = &items
+	}
+	renamed.Disabled = false
+	renamed.Omitted = false
+
+	if _, err := ct.AddField(&renamed); err != nil {
+		return ct, err
+	}
+
+	old.Disabled = true
+	old.Omitted = true
+
+	if ct.DisplayField == oldID {
+		ct.DisplayField = newID
+	}
+
+	return ct, nil
+}
+
+// ChangeFieldType changes the type of the field. The type specific
+// attributes, the link type, items, validations and default value, are
+// cleared, set them for the new type. The api rejects type changes of fields
+// in use, the field has to be deleted first, which deletes its values in all
+// the entries: copy the values to another field first, e.g. with
+// RenameField, to keep them.
+func (ct *ContentType) ChangeFieldType(fieldID, newType string) (*ContentType, error) {
+	field := ct.field(fieldID)
+	if field == nil {
+		return ct, fmt.Errorf("content type has no field %q", fieldID)
+	}
+
+	if !fieldTypes[newType] {
+		return ct, fmt.Errorf("%q is not a field type", newType)
+	}
+
+	if field.Type == newType {
+		return ct, nil
+	}
+
+	field.Type = newType
+	field.LinkType = ""
+	field.Items = nil
+	field.Validations = nil
+	field.DefaultValue = nil
+
+	return ct, nil
+}
+
+// field returns the field with the given id, nil when there is none
+func (ct *ContentType) field(id string) *Field {
+	for _, field := range ct.Fields {
+		if field.ID == id {
+			return field
+		}
+	}
+
+	return nil
+}
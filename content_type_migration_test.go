@@ -0,0 +1,91 @@
+package contentful
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func migrationContentType() *ContentType {
+	return &ContentType{
+		Sys:          &Sys{ID: "cat"},
+		Name:         "Cat",
+		DisplayField: "name",
+		Fields: []*Field{
+			{ID: "name", Name: "Name", Type: FieldTypeSymbol, Required: true},
+			{ID: "lives", Name: "Lives", Type: FieldTypeInteger, Validations: []FieldValidation{
+				FieldValidationRange{Range: &MinMax{Min: 1, Max: 9}},
+			}},
+		},
+	}
+}
+
+func fieldIDs(ct *ContentType) []string {
+	ids := []string{}
+	for _, field := range ct.Fields {
+		ids = append(ids, field.ID)
+	}
+
+	return ids
+}
+
+func TestContentTypeAddField(t *testing.T) {
+	assert := assert.New(t)
+
+	ct, err := migrationContentType().AddField(NewFieldBuilder("color", "Color", FieldTypeSymbol).Build())
+	assert.Nil(err)
+	assert.Equal([]string{"name", "lives", "color"}, fieldIDs(ct))
+
+	_, err = ct.AddField(&Field{ID: "name", Name: "Name", Type: FieldTypeText})
+	assert.NotNil(err)
+	_, err = ct.AddField(&Field{Name: "No id", Type: FieldTypeText})
+	assert.NotNil(err)
+	assert.Equal(3, len(ct.Fields))
+}
+
+func TestContentTypeRenameField(t *testing.T) {
+	assert := assert.New(t)
+
+	ct, err := migrationContentType().RenameField("name", "title")
+	assert.Nil(err)
+	assert.Equal([]string{"name", "lives", "title"}, fieldIDs(ct))
+	assert.Equal("title", ct.DisplayField)
+
+	old, renamed := ct.Fields[0], ct.Fields[2]
+	assert.True(old.Omitted)
+	assert.True(old.Disabled)
+	assert.False(renamed.Omitted)
+	assert.False(renamed.Disabled)
+	assert.Equal("Name", renamed.Name)
+	assert.Equal(FieldTypeSymbol, renamed.Type)
+	assert.True(renamed.Required)
+
+	// the renamed field is ready to be saved
+	assert.Empty(ct.Lint())
+
+	_, err = ct.RenameField("lives", "title")
+	assert.NotNil(err)
+	_, err = ct.RenameField("color", "colour")
+	assert.NotNil(err)
+}
+
+func TestContentTypeChangeFieldType(t *testing.T) {
+	assert := assert.New(t)
+
+	ct, err := migrationContentType().ChangeFieldType("lives", FieldTypeNumber)
+	assert.Nil(err)
+	assert.Equal([]string{"name", "lives"}, fieldIDs(ct))
+
+	lives := ct.Fields[1]
+	assert.Equal(FieldTypeNumber, lives.Type)
+	assert.Nil(lives.Validations)
+	assert.Equal("Lives", lives.Name)
+
+	// the api rejects the change of a field in use
+	assert.NotEmpty(ct.validate(migrationContentType()))
+
+	_, err = ct.ChangeFieldType("lives", "Float")
+	assert.NotNil(err)
+	_, err = ct.ChangeFieldType("color", FieldTypeText)
+	assert.NotNil(err)
+}
@@ -0,0 +1,71 @@
+package contentful
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// ContentTypeSnapshot model, the state of a content type frozen when it was
+// published
+type ContentTypeSnapshot struct {
+	Sys      *Sys         `json:"sys"`
+	Snapshot *ContentType `json:"snapshot"`
+}
+
+// ListSnapshots returns the snapshots collection of the content type
+func (service *ContentTypesService) ListSnapshots(spaceID, contentTypeID string) *Collection {
+	path := service.c.envPath(spaceID, "/content_types/%s/snapshots", contentTypeID)
+
+	req, err := service.c.newRequest(http.MethodGet, path, nil, nil)
+	if err != nil {
+		return &Collection{}
+	}
+
+	col := NewCollection(&CollectionOptions{})
+	col.c = service.c
+	col.req = req
+
+	return col
+}
+
+// GetSnapshot returns a single snapshot of the content type
+func (service *ContentTypesService) GetSnapshot(spaceID, contentTypeID, snapshotID string) (*ContentTypeSnapshot, error) {
+	return service.getSnapshot(context.Background(), spaceID, contentTypeID, snapshotID)
+}
+
+func (service *ContentTypesService) getSnapshot(ctx context.Context, spaceID, contentTypeID, snapshotID string) (*ContentTypeSnapshot, error) {
+	path := service.c.envPath(spaceID, "/content_types/%s/snapshots/%s", contentTypeID, snapshotID)
+
+	req, err := service.c.newRequest(http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshot ContentTypeSnapshot
+	if err := service.c.do(withContext(req, ctx), &snapshot); err != nil {
+		return nil, err
+	}
+
+	return &snapshot, nil
+}
+
+// DiffAgainstSnapshot returns the changes made to the content type since the
+// given snapshot was taken, e.g. since it was last published
+func (service *ContentTypesService) DiffAgainstSnapshot(ctx context.Context, spaceID, contentTypeID, snapshotID string) (ContentTypeDiff, error) {
+	snapshot, err := service.getSnapshot(ctx, spaceID, contentTypeID, snapshotID)
+	if err != nil {
+		return ContentTypeDiff{}, err
+	}
+
+	if snapshot.Snapshot == nil {
+		return ContentTypeDiff{}, fmt.Errorf("snapshot %s of content type %s has no content type", snapshotID, contentTypeID)
+	}
+
+	ct, err := service.get(ctx, spaceID, contentTypeID)
+	if err != nil {
+		return ContentTypeDiff{}, err
+	}
+
+	return DiffContentTypes(snapshot.Snapshot, ct), nil
+}
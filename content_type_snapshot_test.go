@@ -0,0 +1,51 @@
+package contentful
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContentTypesServiceDiffAgainstSnapshot(t *testing.T) {
+	assert := assert.New(t)
+
+	base := "/spaces/" + spaceID + "/environments/master/content_types/63Vgs0BFK0USe4i2mQUGK6"
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("GET", r.Method)
+
+		switch r.URL.Path {
+		case base + "/snapshots/snapshot-1":
+			fmt.Fprintln(w, readTestData("content_type_snapshot.json"))
+		case base:
+			fmt.Fprintln(w, readTestData("content_type.json"))
+		default:
+			w.WriteHeader(404)
+			fmt.Fprintln(w, readTestData("error-notfound.json"))
+		}
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	cma = NewCMA(CMAToken, WithBaseURL(server.URL))
+
+	diff, err := cma.ContentTypes.DiffAgainstSnapshot(context.Background(), spaceID, "63Vgs0BFK0USe4i2mQUGK6", "snapshot-1")
+	assert.Nil(err)
+	assert.True(diff.HasChanges())
+	assert.Equal(0, len(diff.Changes))
+	assert.Equal(0, len(diff.Added))
+	assert.Equal(0, len(diff.Removed))
+	assert.Equal(1, len(diff.Modified))
+	assert.Equal("field2", diff.Modified[0].ID)
+	assert.Equal([]string{"type"}, diff.Modified[0].Changes)
+	assert.Equal(FieldTypeText, diff.Modified[0].Old.Type)
+	assert.Equal(FieldTypeSymbol, diff.Modified[0].New.Type)
+
+	// unknown snapshots are reported
+	_, err = cma.ContentTypes.DiffAgainstSnapshot(context.Background(), spaceID, "63Vgs0BFK0USe4i2mQUGK6", "snapshot-2")
+	assert.NotNil(err)
+}
@@ -1,11 +1,14 @@
 package contentful
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -152,7 +155,7 @@ func TestContentTypesServiceList(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(r.Method, "GET")
-		assert.Equal(r.URL.Path, "/spaces/"+spaceID+"/content_types")
+		assert.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/content_types")
 
 		checkHeaders(r, assert)
 
@@ -172,13 +175,163 @@ func TestContentTypesServiceList(t *testing.T) {
 	assert.Nil(err)
 }
 
+func TestContentTypesServiceEnvironment(t *testing.T) {
+	assert := assert.New(t)
+
+	paths := []string{}
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.Method+" "+r.URL.Path)
+
+		checkHeaders(r, assert)
+
+		w.WriteHeader(200)
+		if r.Method == "DELETE" && !strings.HasSuffix(r.URL.Path, "/published") {
+			return
+		}
+
+		if r.URL.Path == "/spaces/"+spaceID+"/environments/staging/content_types" {
+			fmt.Fprintln(w, readTestData("content_types.json"))
+			return
+		}
+
+		fmt.Fprintln(w, readTestData("content_type.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+	cma.SetEnvironment("staging")
+
+	_, err := cma.ContentTypes.List(spaceID).Next()
+	assert.Nil(err)
+
+	ct, err := cma.ContentTypes.Get(spaceID, "63Vgs0BFK0USe4i2mQUGK6")
+	assert.Nil(err)
+	assert.Nil(cma.ContentTypes.Upsert(spaceID, ct))
+	assert.Nil(cma.ContentTypes.Activate(spaceID, ct))
+	assert.Nil(cma.ContentTypes.Deactivate(spaceID, ct))
+	assert.Nil(cma.ContentTypes.Delete(spaceID, ct))
+
+	base := "/spaces/" + spaceID + "/environments/staging/content_types"
+	assert.Equal([]string{
+		"GET " + base,
+		"GET " + base + "/63Vgs0BFK0USe4i2mQUGK6",
+		"PUT " + base + "/63Vgs0BFK0USe4i2mQUGK6",
+		"PUT " + base + "/63Vgs0BFK0USe4i2mQUGK6/published",
+		"DELETE " + base + "/63Vgs0BFK0USe4i2mQUGK6/published",
+		"DELETE " + base + "/63Vgs0BFK0USe4i2mQUGK6",
+	}, paths)
+}
+
+func TestContentTypesServiceGetPublished(t *testing.T) {
+	var err error
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(r.Method, "GET")
+		assert.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/public/content_types")
+
+		checkHeaders(r, assert)
+
+		w.WriteHeader(200)
+		if r.URL.Query().Get("sys.id") == "63Vgs0BFK0USe4i2mQUGK6" {
+			fmt.Fprintln(w, `{"sys": {"type": "Array"}, "total": 1, "items": [`+readTestData("content_type.json")+`]}`)
+			return
+		}
+
+		fmt.Fprintln(w, `{"sys": {"type": "Array"}, "total": 0, "items": []}`)
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	ct, err := cma.ContentTypes.GetPublished(context.Background(), spaceID, "63Vgs0BFK0USe4i2mQUGK6")
+	assert.Nil(err)
+	assert.Equal("63Vgs0BFK0USe4i2mQUGK6", ct.Sys.ID)
+
+	_, err = cma.ContentTypes.GetPublished(context.Background(), spaceID, "draft-only")
+	assert.NotNil(err)
+}
+
+func TestContentTypesServiceValidate(t *testing.T) {
+	var err error
+	assert := assert.New(t)
+
+	requests := 0
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Equal(r.Method, "GET")
+		assert.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/content_types/63Vgs0BFK0USe4i2mQUGK6")
+
+		checkHeaders(r, assert)
+
+		w.WriteHeader(200)
+		fmt.Fprintln(w, readTestData("content_type.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	ct, err := contentTypeFromTestData("content_type.json")
+	assert.Nil(err)
+	assert.Nil(cma.ContentTypes.Validate(context.Background(), spaceID, ct))
+	assert.Equal(1, requests)
+
+	ct.Fields[1].Type = FieldTypeInteger
+	ct.Fields = append(ct.Fields, &Field{
+		ID:   "field1",
+		Name: "field3-name",
+		Type: FieldTypeLink,
+	})
+
+	err = cma.ContentTypes.Validate(context.Background(), spaceID, ct)
+	validationFailedError, ok := err.(ValidationFailedError)
+	assert.True(ok)
+	assert.Equal(map[string]string{
+		"fields.1.type":     "Field type can not be changed from Symbol to Integer",
+		"fields.2.id":       "Field id \"field1\" is used more than once",
+		"fields.2.linkType": "Value must be one of expected values: Entry, Asset",
+	}, validationFailedError.FieldErrors())
+
+	// new content types are validated without any request
+	err = cma.ContentTypes.Validate(context.Background(), spaceID, &ContentType{
+		Name:         "new",
+		DisplayField: "count",
+		Fields: []*Field{
+			&Field{ID: "count", Name: "Count", Type: FieldTypeInteger},
+			&Field{ID: "tags", Name: "Tags", Type: FieldTypeArray},
+		},
+	})
+	validationFailedError, ok = err.(ValidationFailedError)
+	assert.True(ok)
+	assert.Equal(map[string]string{
+		"displayField":   "Display field \"count\" must be a Symbol or Text field of the content type",
+		"fields.1.items": "The property \"items\" is required here",
+	}, validationFailedError.FieldErrors())
+	assert.Equal(2, requests)
+}
+
 func TestContentTypesServiceActivate(t *testing.T) {
 	var err error
 	assert := assert.New(t)
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(r.Method, "PUT")
-		assert.Equal(r.URL.Path, "/spaces/"+spaceID+"/content_types/63Vgs0BFK0USe4i2mQUGK6/published")
+		assert.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/content_types/63Vgs0BFK0USe4i2mQUGK6/published")
 
 		checkHeaders(r, assert)
 
@@ -208,7 +361,7 @@ func TestContentTypesServiceDeactivate(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(r.Method, "DELETE")
-		assert.Equal(r.URL.Path, "/spaces/"+spaceID+"/content_types/63Vgs0BFK0USe4i2mQUGK6/published")
+		assert.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/content_types/63Vgs0BFK0USe4i2mQUGK6/published")
 
 		checkHeaders(r, assert)
 
@@ -238,7 +391,7 @@ func TestContentTypeSaveForCreate(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(r.Method, "POST")
-		assert.Equal(r.RequestURI, "/spaces/"+spaceID+"/content_types")
+		assert.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/master/content_types")
 		checkHeaders(r, assert)
 
 		var payload map[string]interface{}
@@ -310,7 +463,7 @@ func TestContentTypeSaveForUpdate(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(r.Method, "PUT")
-		assert.Equal(r.RequestURI, "/spaces/"+spaceID+"/content_types/63Vgs0BFK0USe4i2mQUGK6")
+		assert.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/master/content_types/63Vgs0BFK0USe4i2mQUGK6")
 		checkHeaders(r, assert)
 
 		var payload map[string]interface{}
@@ -333,7 +486,7 @@ func TestContentTypeSaveForUpdate(t *testing.T) {
 		assert.Equal("field2", field2["id"].(string))
 		assert.Equal("field2-name-updated", field2["name"].(string))
 		assert.Equal("Integer", field2["type"].(string))
-		assert.Nil(field2["disabled"])
+		assert.Equal(false, field2["disabled"])
 
 		assert.Equal("field3", field3["id"].(string))
 		assert.Equal("field3-name", field3["name"].(string))
@@ -393,7 +546,7 @@ func TestContentTypeCreateWithoutID(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(r.Method, "POST")
-		assert.Equal(r.RequestURI, "/spaces/id1/content_types")
+		assert.Equal(r.RequestURI, "/spaces/id1/environments/master/content_types")
 		checkHeaders(r, assert)
 
 		w.WriteHeader(200)
@@ -424,7 +577,8 @@ func TestContentTypeCreateWithID(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(r.Method, "PUT")
-		assert.Equal(r.RequestURI, "/spaces/id1/content_types/mycontenttype")
+		assert.Equal(r.RequestURI, "/spaces/id1/environments/master/content_types/mycontenttype")
+		assert.Empty(r.Header.Get("X-Contentful-Version"))
 		checkHeaders(r, assert)
 
 		w.WriteHeader(200)
@@ -457,7 +611,7 @@ func TestContentTypeDelete(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(r.Method, "DELETE")
-		assert.Equal(r.RequestURI, "/spaces/"+spaceID+"/content_types/63Vgs0BFK0USe4i2mQUGK6")
+		assert.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/master/content_types/63Vgs0BFK0USe4i2mQUGK6")
 		checkHeaders(r, assert)
 
 		w.WriteHeader(200)
@@ -486,7 +640,7 @@ func TestContentTypeFieldRef(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(r.Method, "POST")
-		assert.Equal(r.RequestURI, "/spaces/"+spaceID+"/content_types")
+		assert.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/master/content_types")
 		checkHeaders(r, assert)
 
 		var payload map[string]interface{}
@@ -522,9 +676,10 @@ func TestContentTypeFieldRef(t *testing.T) {
 	assert.Nil(err)
 
 	field1 := &Field{
-		ID:   "field1",
-		Name: "field1-name",
-		Type: "Link",
+		ID:       "field1",
+		Name:     "field1-name",
+		Type:     "Link",
+		LinkType: "Entry",
 		Validations: []FieldValidation{
 			FieldValidationLink{
 				LinkContentType: []string{linkCt.Sys.ID},
@@ -549,7 +704,7 @@ func TestContentTypeFieldArray(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(r.Method, "POST")
-		assert.Equal(r.RequestURI, "/spaces/"+spaceID+"/content_types")
+		assert.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/master/content_types")
 		checkHeaders(r, assert)
 
 		var payload map[string]interface{}
@@ -612,7 +767,7 @@ func TestContentTypeFieldValidationRangeUniquePredefinedValues(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(r.Method, "POST")
-		assert.Equal(r.RequestURI, "/spaces/"+spaceID+"/content_types")
+		assert.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/master/content_types")
 		checkHeaders(r, assert)
 
 		var payload map[string]interface{}
@@ -699,7 +854,7 @@ func TestContentTypeFieldTypeMedia(t *testing.T) {
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		assert.Equal(r.Method, "POST")
-		assert.Equal(r.RequestURI, "/spaces/"+spaceID+"/content_types")
+		assert.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/master/content_types")
 		checkHeaders(r, assert)
 
 		var payload map[string]interface{}
@@ -899,6 +1054,7 @@ func TestContentTypeFieldValidationsUnmarshal(t *testing.T) {
 		if field.Name == "media-manyfiles" {
 			assert.Equal(1, len(field.Validations))
 			assert.Equal(3, len(field.Items.Validations))
+			assert.Equal("Asset", field.Items.LinkType)
 			sizeValidations = append(sizeValidations, field.Validations[0])
 			mimeTypeValidations = append(mimeTypeValidations, field.Items.Validations[0])
 			dimensionValidations = append(dimensionValidations, field.Items.Validations[1])
@@ -973,3 +1129,752 @@ func TestContentTypeFieldValidationsUnmarshal(t *testing.T) {
 		assert.True(ok)
 	}
 }
+
+func TestContentTypeFieldDefaultValue(t *testing.T) {
+	var err error
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		checkHeaders(r, assert)
+
+		if r.Method == "POST" {
+			var payload map[string]interface{}
+			err := json.NewDecoder(r.Body).Decode(&payload)
+			assert.Nil(err)
+
+			fields := payload["fields"].([]interface{})
+			field1 := fields[0].(map[string]interface{})
+			assert.Equal("Symbol", field1["type"].(string))
+			assert.Equal(map[string]interface{}{"en-US": "draft"}, field1["defaultValue"])
+		}
+
+		w.WriteHeader(200)
+		fmt.Fprintln(w, string(readTestData("content_type_with_default_value.json")))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	field1 := &Field{
+		ID:   "status",
+		Name: "status",
+		Type: FieldTypeSymbol,
+		DefaultValue: map[string]interface{}{
+			"en-US": "draft",
+		},
+	}
+
+	ct := &ContentType{
+		Name:         "ct-name",
+		Description:  "ct-description",
+		Fields:       []*Field{field1},
+		DisplayField: field1.ID,
+	}
+
+	err = cma.ContentTypes.Upsert(spaceID, ct)
+	assert.Nil(err)
+	assert.Equal(map[string]interface{}{"en-US": "draft"}, ct.Fields[0].DefaultValue)
+
+	ct, err = cma.ContentTypes.Get(spaceID, ct.Sys.ID)
+	assert.Nil(err)
+	assert.Equal("draft", ct.Fields[0].DefaultValue["en-US"])
+
+	// a null default value is no default value, anything but a locale map is
+	// an error
+	var field Field
+	assert.Nil(json.Unmarshal([]byte(`{"id": "status", "defaultValue": null}`), &field))
+	assert.Nil(field.DefaultValue)
+	assert.NotNil(json.Unmarshal([]byte(`{"id": "status", "defaultValue": "draft"}`), &field))
+}
+
+func TestContentTypeFieldValidationProhibitRegexp(t *testing.T) {
+	var err error
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		checkHeaders(r, assert)
+
+		var payload map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assert.Nil(err)
+
+		fields := payload["fields"].([]interface{})
+		field1 := fields[0].(map[string]interface{})
+		validations := field1["validations"].([]interface{})
+		assert.Equal(1, len(validations))
+
+		validation := validations[0].(map[string]interface{})
+		prohibitRegexp := validation["prohibitRegexp"].(map[string]interface{})
+		assert.Equal("darn|heck", prohibitRegexp["pattern"].(string))
+		assert.Equal("i", prohibitRegexp["flags"].(string))
+		assert.Equal("no profanity please", validation["message"].(string))
+
+		// echo the content type back so the response goes through unmarshaling
+		payload["sys"] = map[string]interface{}{"id": "ct-id", "version": 1}
+		w.WriteHeader(201)
+		json.NewEncoder(w).Encode(payload)
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	ct := &ContentType{
+		Name: "ct-name",
+		Fields: []*Field{
+			&Field{
+				ID:   "title",
+				Name: "title",
+				Type: FieldTypeSymbol,
+				Validations: []FieldValidation{
+					&FieldValidationProhibitRegexp{
+						Pattern:      "darn|heck",
+						Flags:        "i",
+						ErrorMessage: "no profanity please",
+					},
+				},
+			},
+		},
+	}
+
+	err = cma.ContentTypes.Upsert(spaceID, ct)
+	assert.Nil(err)
+
+	validation, ok := ct.Fields[0].Validations[0].(FieldValidationProhibitRegexp)
+	assert.True(ok)
+	assert.Equal("darn|heck", validation.Pattern)
+	assert.Equal("i", validation.Flags)
+	assert.Equal("no profanity please", validation.ErrorMessage)
+
+	// a second upsert sends the unmarshaled validation back unchanged
+	err = cma.ContentTypes.Upsert(spaceID, ct)
+	assert.Nil(err)
+}
+
+func TestContentTypeFieldTypeMediaArray(t *testing.T) {
+	var err error
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(r.Method, "POST")
+		assert.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/master/content_types")
+		checkHeaders(r, assert)
+
+		var payload map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assert.Nil(err)
+
+		fields := payload["fields"].([]interface{})
+		assert.Equal(1, len(fields))
+
+		field1 := fields[0].(map[string]interface{})
+		assert.Equal("Array", field1["type"].(string))
+		assert.Nil(field1["validations"])
+
+		items := field1["items"].(map[string]interface{})
+		assert.Equal("Link", items["type"].(string))
+		assert.Equal("Asset", items["linkType"].(string))
+
+		validations := items["validations"].([]interface{})
+		assert.Equal(3, len(validations))
+
+		// mime type validation
+		validationMimeType := validations[0].(map[string]interface{})
+		linkMimetypeGroup := validationMimeType["linkMimetypeGroup"].([]interface{})
+		assert.Equal([]interface{}{MimeTypeImage}, linkMimetypeGroup)
+
+		// dimension validation
+		validationDimension := validations[1].(map[string]interface{})
+		assetImageDimensions := validationDimension["assetImageDimensions"].(map[string]interface{})
+		widthData := assetImageDimensions["width"].(map[string]interface{})
+		assert.Equal(float64(100), widthData["min"].(float64))
+		assert.Equal(float64(200), widthData["max"].(float64))
+		assert.Nil(assetImageDimensions["height"])
+		assert.Nil(validationDimension["width"])
+		assert.Equal("dimension error message", validationDimension["message"].(string))
+
+		// size validation
+		validationSize := validations[2].(map[string]interface{})
+		sizeData := validationSize["assetFileSize"].(map[string]interface{})
+		assert.Equal(float64(1048576), sizeData["max"].(float64))
+
+		w.WriteHeader(201)
+		fmt.Fprintln(w, string(readTestData("content_type.json")))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	field1 := &Field{
+		ID:   "gallery",
+		Name: "gallery",
+		Type: FieldTypeArray,
+		Items: &FieldTypeArrayItem{
+			Type:     FieldTypeLink,
+			LinkType: "Asset",
+			Validations: []FieldValidation{
+				&FieldValidationMimeType{
+					MimeTypes: []string{MimeTypeImage},
+				},
+				// validations parsed from a response are values, not pointers
+				FieldValidationDimension{
+					Width: &MinMax{
+						Min: 100,
+						Max: 200,
+					},
+					ErrorMessage: "dimension error message",
+				},
+				&FieldValidationFileSize{
+					Size: &MinMax{
+						Max: 1048576,
+					},
+				},
+			},
+		},
+	}
+
+	ct := &ContentType{
+		Name:         "ct-name",
+		Description:  "ct-description",
+		Fields:       []*Field{field1},
+		DisplayField: field1.ID,
+	}
+
+	err = cma.ContentTypes.Upsert("id1", ct)
+	assert.Nil(err)
+}
+
+func TestContentTypeFieldValidationsRichText(t *testing.T) {
+	var err error
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		checkHeaders(r, assert)
+
+		if r.Method == "PUT" {
+			var payload map[string]interface{}
+			err := json.NewDecoder(r.Body).Decode(&payload)
+			assert.Nil(err)
+
+			fields := payload["fields"].([]interface{})
+			body := fields[1].(map[string]interface{})
+			validations := body["validations"].([]interface{})
+			assert.Equal(3, len(validations))
+
+			enabledNodeTypes := validations[0].(map[string]interface{})
+			assert.Equal(10, len(enabledNodeTypes["enabledNodeTypes"].([]interface{})))
+
+			enabledMarks := validations[1].(map[string]interface{})
+			assert.Equal([]interface{}{"bold", "italic", "code"}, enabledMarks["enabledMarks"])
+
+			nodes := validations[2].(map[string]interface{})["nodes"].(map[string]interface{})
+			entryHyperlink := nodes["entry-hyperlink"].([]interface{})
+			assert.Equal(map[string]interface{}{
+				"linkContentType": []interface{}{"article"},
+				"message":         "Only articles can be linked",
+			}, entryHyperlink[0])
+			assert.Equal(2, len(nodes["embedded-entry-block"].([]interface{})))
+		}
+
+		w.WriteHeader(200)
+		fmt.Fprintln(w, string(readTestData("content_type_with_rich_text.json")))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	ct, err := cma.ContentTypes.Get(spaceID, "article")
+	assert.Nil(err)
+
+	body := ct.Fields[1]
+	assert.Equal(3, len(body.Validations))
+
+	enabledNodeTypes, ok := body.Validations[0].(FieldValidationEnabledNodeTypes)
+	assert.True(ok)
+	assert.Equal(10, len(enabledNodeTypes.NodeTypes))
+	assert.Equal("Only headings, lists, quotes, links and embeds are allowed", enabledNodeTypes.ErrorMessage)
+
+	enabledMarks, ok := body.Validations[1].(FieldValidationEnabledMarks)
+	assert.True(ok)
+	assert.Equal([]string{"bold", "italic", "code"}, enabledMarks.Marks)
+
+	nodes, ok := body.Validations[2].(FieldValidationRichTextNodes)
+	assert.True(ok)
+	assert.Equal(2, len(nodes.Nodes))
+
+	embeddedEntryBlock := nodes.Nodes["embedded-entry-block"]
+	assert.Equal(2, len(embeddedEntryBlock))
+	link, ok := embeddedEntryBlock[0].(FieldValidationLink)
+	assert.True(ok)
+	assert.Equal([]string{"quote", "video"}, link.LinkContentType)
+	size, ok := embeddedEntryBlock[1].(FieldValidationSize)
+	assert.True(ok)
+	assert.Equal(float64(5), size.Size.Max)
+
+	err = cma.ContentTypes.Upsert(spaceID, ct)
+	assert.Nil(err)
+}
+
+func TestContentTypesServiceGetIfModified(t *testing.T) {
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("GET", r.Method)
+		assert.Equal("/spaces/"+spaceID+"/environments/master/content_types/63Vgs0BFK0USe4i2mQUGK6", r.URL.Path)
+
+		if r.Header.Get("If-None-Match") == `"v3"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		w.Header().Set("ETag", `"v3"`)
+		fmt.Fprintln(w, readTestData("content_type.json"))
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	cached, etag, notModified, err := cma.ContentTypes.GetIfModified(context.Background(), spaceID, "63Vgs0BFK0USe4i2mQUGK6", `"v2"`)
+	assert.Nil(err)
+	assert.False(notModified)
+	assert.Equal(`"v3"`, etag)
+	assert.Equal("63Vgs0BFK0USe4i2mQUGK6", cached.Sys.ID)
+
+	// the etag passed in is returned when the 304 response has none
+	ct, etag, notModified, err := cma.ContentTypes.GetIfModified(context.Background(), spaceID, "63Vgs0BFK0USe4i2mQUGK6", etag)
+	assert.Nil(err)
+	assert.True(notModified)
+	assert.Nil(ct)
+	assert.Equal(`"v3"`, etag)
+}
+
+func TestContentTypeLint(t *testing.T) {
+	assert := assert.New(t)
+
+	restricted := []FieldValidation{FieldValidationLink{LinkContentType: []string{"cat"}}}
+
+	tests := []struct {
+		name   string
+		ct     *ContentType
+		errors []string
+	}{
+		{
+			name: "valid",
+			ct: &ContentType{
+				Name:         "Cat",
+				DisplayField: "name",
+				Fields: []*Field{
+					{ID: "name", Name: "Name", Type: FieldTypeSymbol},
+					{ID: "friend", Name: "Friend", Type: FieldTypeLink, LinkType: "Entry", Validations: restricted},
+					{ID: "image", Name: "Image", Type: FieldTypeLink, LinkType: "Asset"},
+				},
+			},
+		},
+		{
+			name: "display field not a field",
+			ct: &ContentType{
+				Name:         "Cat",
+				DisplayField: "title",
+				Fields: []*Field{
+					{ID: "name", Name: "Name", Type: FieldTypeSymbol},
+				},
+			},
+			errors: []string{`displayField: Display field "title" must be a Symbol or Text field of the content type`},
+		},
+		{
+			name: "duplicate field ids",
+			ct: &ContentType{
+				Name: "Cat",
+				Fields: []*Field{
+					{ID: "name", Name: "Name", Type: FieldTypeSymbol},
+					{ID: "name", Name: "Other name", Type: FieldTypeSymbol},
+				},
+			},
+			errors: []string{`fields.1.id: Field id "name" is used more than once`},
+		},
+		{
+			name: "unrestricted link",
+			ct: &ContentType{
+				Name: "Cat",
+				Fields: []*Field{
+					{ID: "friend", Name: "Friend", Type: FieldTypeLink, LinkType: "Entry"},
+				},
+			},
+			errors: []string{`fields.0.validations: Link field "friend" does not restrict the content types it links to`},
+		},
+		{
+			name: "unrestricted array of links",
+			ct: &ContentType{
+				Name: "Cat",
+				Fields: []*Field{
+					{ID: "friends", Name: "Friends", Type: FieldTypeArray, Items: &FieldTypeArrayItem{Type: FieldTypeLink, LinkType: "Entry"}},
+					{ID: "rivals", Name: "Rivals", Type: FieldTypeArray, Items: &FieldTypeArrayItem{Type: FieldTypeLink, LinkType: "Entry", Validations: restricted}},
+				},
+			},
+			errors: []string{`fields.0.items.validations: Link field "friends" does not restrict the content types it links to`},
+		},
+	}
+
+	for _, test := range tests {
+		messages := []string{}
+		for _, err := range test.ct.Lint() {
+			messages = append(messages, err.Error())
+		}
+
+		if test.errors == nil {
+			test.errors = []string{}
+		}
+
+		assert.Equal(test.errors, messages, test.name)
+	}
+}
+
+func TestContentTypesServiceUpsertWithValidation(t *testing.T) {
+	assert := assert.New(t)
+
+	requests := 0
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprintln(w, readTestData("content_type.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken, WithBaseURL(server.URL), WithContentTypeValidation())
+
+	ct := &ContentType{
+		Name:         "Cat",
+		DisplayField: "title",
+		Fields: []*Field{
+			{ID: "name", Name: "Name", Type: FieldTypeSymbol},
+		},
+	}
+
+	err := cma.ContentTypes.Upsert(spaceID, ct)
+	_, ok := err.(ValidationFailedError)
+	assert.True(ok)
+	assert.Equal(0, requests)
+
+	ct.DisplayField = "name"
+	assert.Nil(cma.ContentTypes.Upsert(spaceID, ct))
+	assert.Equal(1, requests)
+}
+
+func TestFieldReEnable(t *testing.T) {
+	assert := assert.New(t)
+
+	var field Field
+	err := json.Unmarshal([]byte(`{"id": "name", "name": "Name", "type": "Symbol", "disabled": true, "omitted": true}`), &field)
+	assert.Nil(err)
+	assert.True(field.Disabled)
+	assert.True(field.Omitted)
+
+	field.Disabled = false
+	field.Omitted = false
+
+	byteArray, err := json.Marshal(&field)
+	assert.Nil(err)
+	assert.JSONEq(`{"id": "name", "name": "Name", "type": "Symbol", "disabled": false, "omitted": false}`, string(byteArray))
+}
+
+func TestFieldLocalized(t *testing.T) {
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]interface{}
+		if r.Method == "PUT" {
+			assert.Nil(json.NewDecoder(r.Body).Decode(&payload))
+			fields := payload["fields"].([]interface{})
+			assert.Equal(true, fields[0].(map[string]interface{})["localized"])
+		}
+
+		fmt.Fprintln(w, `{
+			"sys": {"id": "cat", "version": 2},
+			"name": "Cat",
+			"fields": [{"id": "name", "name": "Name", "type": "Symbol", "localized": true}]
+		}`)
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	ct := &ContentType{
+		Sys:  &Sys{ID: "cat"},
+		Name: "Cat",
+		Fields: []*Field{
+			NewFieldBuilder("name", "Name", FieldTypeSymbol).Localized().Build(),
+		},
+	}
+
+	assert.Nil(cma.ContentTypes.Upsert(spaceID, ct))
+	assert.True(ct.Fields[0].Localized)
+
+	ct, err := cma.ContentTypes.Get(spaceID, "cat")
+	assert.Nil(err)
+	assert.True(ct.Fields[0].Localized)
+}
+
+func TestFieldTypes(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, fieldType := range []string{
+		FieldTypeSymbol,
+		FieldTypeText,
+		FieldTypeInteger,
+		FieldTypeNumber,
+		FieldTypeDate,
+		FieldTypeBoolean,
+		FieldTypeLocation,
+		FieldTypeObject,
+		FieldTypeRichText,
+		FieldTypeLink,
+		FieldTypeArray,
+	} {
+		assert.True(fieldTypes[fieldType], fieldType)
+	}
+
+	assert.False(fieldTypes["Bool"])
+
+	ct := &ContentType{
+		Name: "Cat",
+		Fields: []*Field{
+			{ID: "lazy", Name: "Lazy", Type: "Bool"},
+			{ID: "friend", Name: "Friend", Type: FieldTypeLink, LinkType: LinkTypeAsset},
+		},
+	}
+
+	errors := ct.Lint()
+	assert.Equal(1, len(errors))
+	assert.EqualError(errors[0], `fields.0.type: Value "Bool" is not a field type`)
+}
+
+func TestFieldValidate(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		field *Field
+		err   string
+	}{
+		{&Field{ID: "name", Type: FieldTypeSymbol}, ""},
+		{&Field{ID: "friend", Type: FieldTypeLink, LinkType: LinkTypeEntry}, ""},
+		{&Field{ID: "friend", Type: FieldTypeLink}, `field "friend" of type Link requires a linkType of Entry or Asset, got ""`},
+		{&Field{ID: "friend", Type: FieldTypeLink, LinkType: "Space"}, `field "friend" of type Link requires a linkType of Entry or Asset, got "Space"`},
+		{&Field{ID: "images", Type: FieldTypeArray, Items: &FieldTypeArrayItem{Type: FieldTypeLink, LinkType: LinkTypeAsset}}, ""},
+		{&Field{ID: "images", Type: FieldTypeArray, Items: &FieldTypeArrayItem{Type: FieldTypeLink}}, `items of field "images" of type Array of Link require a linkType of Entry or Asset, got ""`},
+		{&Field{ID: "tags", Type: FieldTypeArray, Items: &FieldTypeArrayItem{Type: FieldTypeSymbol}}, ""},
+	}
+
+	for _, test := range tests {
+		err := test.field.Validate()
+		if test.err == "" {
+			assert.Nil(err)
+			continue
+		}
+
+		assert.EqualError(err, test.err)
+	}
+}
+
+func TestContentTypesServiceUpsertLinkWithoutLinkType(t *testing.T) {
+	assert := assert.New(t)
+
+	requests := 0
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	ct := &ContentType{
+		Name: "Cat",
+		Fields: []*Field{
+			{ID: "friend", Name: "Friend", Type: FieldTypeLink},
+		},
+	}
+
+	assert.NotNil(cma.ContentTypes.Upsert(spaceID, ct))
+	assert.Equal(0, requests)
+}
+
+func TestContentTypesServiceActivateAll(t *testing.T) {
+	assert := assert.New(t)
+
+	active := map[string]bool{}
+	activated := []string{}
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("PUT", r.Method)
+
+		id := strings.Split(r.URL.Path, "/")[6]
+
+		// activating a content type linking to an inactive one fails
+		if id == "b" && !active["a"] {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			fmt.Fprintln(w, readTestData("error-validationfailed.json"))
+			return
+		}
+
+		active[id] = true
+		activated = append(activated, id)
+
+		fmt.Fprintf(w, `{"sys": {"id": %q, "version": 2}}`, id)
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	cma = NewCMA(CMAToken, WithBaseURL(server.URL))
+
+	a := &ContentType{Sys: &Sys{ID: "a", Version: 1}, Name: "A"}
+	b := &ContentType{
+		Sys:  &Sys{ID: "b", Version: 1},
+		Name: "B",
+		Fields: []*Field{
+			{
+				ID:       "a",
+				Type:     FieldTypeLink,
+				LinkType: LinkTypeEntry,
+				Validations: []FieldValidation{
+					FieldValidationLink{LinkContentType: []string{"a"}},
+				},
+			},
+		},
+	}
+
+	err := cma.ContentTypes.ActivateAll(context.Background(), spaceID, []*ContentType{b, a})
+	assert.Nil(err)
+	assert.Equal([]string{"a", "b"}, activated)
+	assert.Equal(2, a.Sys.Version)
+	assert.Equal(2, b.Sys.Version)
+}
+
+func TestContentTypesServiceActivateAllRetry(t *testing.T) {
+	assert := assert.New(t)
+
+	active := map[string]bool{}
+	attempts := map[string]int{}
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := strings.Split(r.URL.Path, "/")[6]
+		attempts[id]++
+
+		// a and b link to each other, b needs a active, c never activates
+		if id == "c" || (id == "b" && !active["a"]) {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			fmt.Fprintln(w, readTestData("error-validationfailed.json"))
+			return
+		}
+
+		active[id] = true
+		fmt.Fprintf(w, `{"sys": {"id": %q, "version": 2}}`, id)
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	cma = NewCMA(CMAToken, WithBaseURL(server.URL))
+
+	linkTo := func(id string) []*Field {
+		return []*Field{{
+			ID:   id,
+			Type: FieldTypeArray,
+			Items: &FieldTypeArrayItem{
+				Type:        FieldTypeLink,
+				LinkType:    LinkTypeEntry,
+				Validations: []FieldValidation{FieldValidationLink{LinkContentType: []string{id}}},
+			},
+		}}
+	}
+
+	a := &ContentType{Sys: &Sys{ID: "a", Version: 1}, Fields: linkTo("b")}
+	b := &ContentType{Sys: &Sys{ID: "b", Version: 1}, Fields: linkTo("a")}
+	c := &ContentType{Sys: &Sys{ID: "c", Version: 1}}
+
+	err := cma.ContentTypes.ActivateAll(context.Background(), spaceID, []*ContentType{a, b, c})
+	assert.IsType(ActivateAllError{}, err)
+	assert.Len(err.(ActivateAllError).Errors, 1)
+	assert.IsType(ValidationFailedError{}, err.(ActivateAllError).Errors["c"])
+	assert.Contains(err.Error(), "failed to activate 1 content types: c: ")
+
+	// the cycle is broken by b, which is retried after a got activated
+	assert.Equal(1, attempts["a"])
+	assert.Equal(2, attempts["b"])
+	assert.Equal(3, attempts["c"])
+}
+
+func TestContentTypesServiceLifecycleVersions(t *testing.T) {
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// every operation bumps the version sent with it, creates are sent
+		// without a version
+		version := 0
+		if r.Method != "POST" {
+			var err error
+			version, err = strconv.Atoi(r.Header.Get("X-Contentful-Version"))
+			assert.Nil(err)
+		} else {
+			assert.Empty(r.Header.Get("X-Contentful-Version"))
+		}
+
+		fmt.Fprintf(w, `{"sys": {"id": "cat", "version": %d, "createdAt": "2018-01-01T00:00:00Z"}, "name": "Cat"}`, version+1)
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	cma = NewCMA(CMAToken, WithBaseURL(server.URL))
+
+	ct := &ContentType{Name: "Cat"}
+
+	assert.Nil(cma.ContentTypes.Upsert(spaceID, ct))
+	assert.Equal(1, ct.Sys.Version)
+
+	assert.Nil(cma.ContentTypes.Activate(spaceID, ct))
+	assert.Equal(2, ct.Sys.Version)
+
+	assert.Nil(cma.ContentTypes.Upsert(spaceID, ct))
+	assert.Equal(3, ct.Sys.Version)
+
+	assert.Nil(cma.ContentTypes.Deactivate(spaceID, ct))
+	assert.Equal(4, ct.Sys.Version)
+
+	assert.Nil(cma.ContentTypes.ActivateAll(context.Background(), spaceID, []*ContentType{ct}))
+	assert.Equal(5, ct.Sys.Version)
+}
@@ -1,19 +1,32 @@
 package contentful
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"log"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
 	"strconv"
+	"sync"
 	"time"
 )
 
-// Client model
+// Client model. A client is safe for concurrent use: requests may be made
+// from several goroutines at once, and the Set* methods may be called while
+// requests are in flight. The exported fields are not guarded, set them
+// before the client is shared, or use WithEnvironment to get a copy scoped to
+// another environment.
+//
+// Requests are sent to BaseURL, except for uploads which are sent to
+// UploadURL. The base url of CDA and CPA clients defaults to DeliveryURL and
+// PreviewURL respectively, set the hosts with the With*URL options, e.g. for
+// a dedicated or regional host.
 type Client struct {
+	mu            *sync.RWMutex
 	client        *http.Client
 	api           string
 	token         string
@@ -21,7 +34,17 @@ type Client struct {
 	QueryParams   map[string]string
 	Headers       map[string]string
 	BaseURL       string
+	UploadURL     string
+	DeliveryURL   string
+	PreviewURL    string
 	Environment   string
+	retryPolicy   RetryPolicy
+	logger        *log.Logger
+	tracer        Tracer
+	metrics       Metrics
+	contentTypes  *contentTypeCache
+	validateCTs   bool
+	useNumber     bool
 	commonService service
 
 	Spaces       *SpacesService
@@ -31,15 +54,40 @@ type Client struct {
 	Entries      *EntriesService
 	Locales      *LocalesService
 	Webhooks     *WebhooksService
+	Sync         *SyncService
+
+	EnvironmentAliases   *EnvironmentAliasesService
+	PersonalAccessTokens *PersonalAccessTokensService
+	UIExtensions         *UIExtensionsService
+	AppDefinitions       *AppDefinitionsService
+	AppInstallations     *AppInstallationsService
+	Export               *ExportService
+	Usage                *UsageService
+	Comments             *CommentsService
+	Tasks                *TasksService
+	Releases             *ReleasesService
+	Uploads              *UploadsService
 }
 
+const (
+	defaultBaseURL     = "https://api.contentful.com"
+	defaultUploadURL   = "https://" + uploadHost
+	defaultDeliveryURL = "https://cdn.contentful.com"
+	defaultPreviewURL  = "https://preview.contentful.com"
+
+	// defaultEnvironment is the environment requests are scoped to when none
+	// is set
+	defaultEnvironment = "master"
+)
+
 type service struct {
 	c *Client
 }
 
-// NewCMA returns a CMA client
-func NewCMA(token string) *Client {
+// NewCMA returns a CMA client configured by the given options
+func NewCMA(token string, opts ...Option) *Client {
 	c := &Client{
+		mu:     &sync.RWMutex{},
 		client: http.DefaultClient,
 		api:    "CMA",
 		token:  token,
@@ -49,24 +97,29 @@ func NewCMA(token string) *Client {
 			"Content-Type":            "application/vnd.contentful.management.v1+json",
 			"X-Contentful-User-Agent": fmt.Sprintf("sdk contentful-go/%s", Version),
 		},
-		BaseURL:     "https://api.contentful.com",
-		Environment: "master",
+		BaseURL:     defaultBaseURL,
+		UploadURL:   defaultUploadURL,
+		DeliveryURL: defaultDeliveryURL,
+		PreviewURL:  defaultPreviewURL,
+		Environment: defaultEnvironment,
+		retryPolicy: NewDefaultRetryPolicy(),
+		tracer:      noopTracer{},
+		metrics:     noopMetrics{},
 	}
-	c.commonService.c = c
 
-	c.Spaces = (*SpacesService)(&c.commonService)
-	c.APIKeys = (*APIKeyService)(&c.commonService)
-	c.Assets = (*AssetsService)(&c.commonService)
-	c.ContentTypes = (*ContentTypesService)(&c.commonService)
-	c.Entries = (*EntriesService)(&c.commonService)
-	c.Locales = (*LocalesService)(&c.commonService)
-	c.Webhooks = (*WebhooksService)(&c.commonService)
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	c.initServices()
+
 	return c
 }
 
-// NewCDA returns a CDA client
-func NewCDA(token string) *Client {
+// NewCDA returns a CDA client configured by the given options
+func NewCDA(token string, opts ...Option) *Client {
 	c := &Client{
+		mu:     &sync.RWMutex{},
 		client: http.DefaultClient,
 		api:    "CDA",
 		token:  token,
@@ -76,25 +129,34 @@ func NewCDA(token string) *Client {
 			"Content-Type":            "application/vnd.contentful.delivery.v1+json",
 			"X-Contentful-User-Agent": fmt.Sprintf("sdk contentful-go/%s", Version),
 		},
-		BaseURL:     "https://cdn.contentful.com",
-		Environment: "master",
+		UploadURL:   defaultUploadURL,
+		DeliveryURL: defaultDeliveryURL,
+		PreviewURL:  defaultPreviewURL,
+		Environment: defaultEnvironment,
+		retryPolicy: NewDefaultRetryPolicy(),
+		tracer:      noopTracer{},
+		metrics:     noopMetrics{},
 	}
-	c.commonService.c = c
 
-	c.Spaces = (*SpacesService)(&c.commonService)
-	c.APIKeys = (*APIKeyService)(&c.commonService)
-	c.Assets = (*AssetsService)(&c.commonService)
-	c.ContentTypes = (*ContentTypesService)(&c.commonService)
-	c.Entries = (*EntriesService)(&c.commonService)
-	c.Locales = (*LocalesService)(&c.commonService)
-	c.Webhooks = (*WebhooksService)(&c.commonService)
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	// the delivery api is served from the delivery host unless the base url
+	// is set explicitly
+	if c.BaseURL == "" {
+		c.BaseURL = c.DeliveryURL
+	}
+
+	c.initServices()
 
 	return c
 }
 
-// NewCPA returns a CPA client
-func NewCPA(token string) *Client {
+// NewCPA returns a CPA client configured by the given options
+func NewCPA(token string, opts ...Option) *Client {
 	c := &Client{
+		mu:     &sync.RWMutex{},
 		client: http.DefaultClient,
 		Debug:  false,
 		api:    "CPA",
@@ -102,22 +164,86 @@ func NewCPA(token string) *Client {
 		Headers: map[string]string{
 			"Authorization": "Bearer " + token,
 		},
-		BaseURL: "https://preview.contentful.com",
+		UploadURL:   defaultUploadURL,
+		DeliveryURL: defaultDeliveryURL,
+		PreviewURL:  defaultPreviewURL,
+		retryPolicy: NewDefaultRetryPolicy(),
+		tracer:      noopTracer{},
+		metrics:     noopMetrics{},
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if c.BaseURL == "" {
+		c.BaseURL = c.PreviewURL
 	}
 
-	c.Spaces = &SpacesService{c: c}
-	c.APIKeys = &APIKeyService{c: c}
-	c.Assets = &AssetsService{c: c}
-	c.ContentTypes = &ContentTypesService{c: c}
-	c.Entries = &EntriesService{c: c}
-	c.Locales = &LocalesService{c: c}
-	c.Webhooks = &WebhooksService{c: c}
+	c.initServices()
 
 	return c
 }
 
+func (c *Client) initServices() {
+	c.commonService.c = c
+	c.contentTypes = newContentTypeCache()
+
+	c.Spaces = (*SpacesService)(&c.commonService)
+	c.APIKeys = (*APIKeyService)(&c.commonService)
+	c.Assets = (*AssetsService)(&c.commonService)
+	c.ContentTypes = (*ContentTypesService)(&c.commonService)
+	c.Entries = (*EntriesService)(&c.commonService)
+	c.Locales = (*LocalesService)(&c.commonService)
+	c.Webhooks = (*WebhooksService)(&c.commonService)
+	c.Sync = (*SyncService)(&c.commonService)
+	c.EnvironmentAliases = (*EnvironmentAliasesService)(&c.commonService)
+	c.PersonalAccessTokens = (*PersonalAccessTokensService)(&c.commonService)
+	c.UIExtensions = (*UIExtensionsService)(&c.commonService)
+	c.AppDefinitions = (*AppDefinitionsService)(&c.commonService)
+	c.AppInstallations = (*AppInstallationsService)(&c.commonService)
+	c.Export = (*ExportService)(&c.commonService)
+	c.Usage = (*UsageService)(&c.commonService)
+	c.Comments = (*CommentsService)(&c.commonService)
+	c.Tasks = (*TasksService)(&c.commonService)
+	c.Releases = (*ReleasesService)(&c.commonService)
+	c.Uploads = (*UploadsService)(&c.commonService)
+}
+
+// WithEnvironment returns a copy of the client scoped to the given
+// environment, leaving the client itself untouched. The copy has its own
+// headers and query params but shares the underlying http.Client, and so its
+// transport and connection pool, with the client.
+func (c *Client) WithEnvironment(environment string) *Client {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	scoped := *c
+	scoped.mu = &sync.RWMutex{}
+	scoped.Environment = environment
+
+	scoped.Headers = make(map[string]string, len(c.Headers))
+	for key, value := range c.Headers {
+		scoped.Headers[key] = value
+	}
+
+	if c.QueryParams != nil {
+		scoped.QueryParams = make(map[string]string, len(c.QueryParams))
+		for key, value := range c.QueryParams {
+			scoped.QueryParams[key] = value
+		}
+	}
+
+	scoped.initServices()
+
+	return &scoped
+}
+
 // SetOrganization sets the given organization id
 func (c *Client) SetOrganization(organizationID string) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	c.Headers["X-Contentful-Organization"] = organizationID
 
 	return c
@@ -126,17 +252,63 @@ func (c *Client) SetOrganization(organizationID string) *Client {
 // SetEnvironment sets the given environment.
 // https://www.contentful.com/developers/docs/references/content-management-api/#/reference/environments
 func (c *Client) SetEnvironment(environment string) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	c.Environment = environment
 	return c
 }
 
+// environment returns the environment requests are scoped to, master when
+// none is set, so that paths never have an empty environment segment
+func (c *Client) environment() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.Environment == "" {
+		return defaultEnvironment
+	}
+
+	return c.Environment
+}
+
+// validatesContentTypes reports whether content types are validated before
+// they are upserted
+func (c *Client) validatesContentTypes() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.validateCTs
+}
+
+// SetRetryPolicy sets the policy failed requests are retried by, nil
+// disables retries
+func (c *Client) SetRetryPolicy(policy RetryPolicy) *Client {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.retryPolicy = policy
+	return c
+}
+
 // SetHTTPClient sets the underlying http.Client used to make requests.
 func (c *Client) SetHTTPClient(client *http.Client) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	c.client = client
 }
 
-func (c *Client) newRequest(method, path string, query url.Values, body io.Reader) (*http.Request, error) {
-	u, err := url.Parse(c.BaseURL)
+func (c *Client) newRequest(method string, path apiPath, query url.Values, body io.Reader) (*http.Request, error) {
+	return c.newHostRequest(c.BaseURL, method, path, query, body)
+}
+
+// newHostRequest returns a request to the given host, e.g. the UploadURL
+func (c *Client) newHostRequest(baseURL, method string, path apiPath, query url.Values, body io.Reader) (*http.Request, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	u, err := url.Parse(baseURL)
 	if err != nil {
 		return nil, err
 	}
@@ -146,10 +318,12 @@ func (c *Client) newRequest(method, path string, query url.Values, body io.Reade
 		query.Set(key, value)
 	}
 
-	u.Path = path
+	u.Path = path.path
 	u.RawQuery = query.Encode()
 
-	req, err := http.NewRequest(method, u.String(), body)
+	ctx := context.WithValue(context.Background(), apiPathKey{}, path)
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -162,58 +336,200 @@ func (c *Client) newRequest(method, path string, query url.Values, body io.Reade
 	return req, nil
 }
 
-func (c *Client) do(req *http.Request, v interface{}) error {
-	res, err := c.client.Do(req)
+// Do sends a request to an endpoint the client does not model, e.g.
+// "/spaces/<space-id>/environments/<environment-id>/tags". The path is sent
+// to BaseURL as given, with the client's authorization, headers and query
+// params, overrides stored in ctx and the retry policy applied like for any
+// other request. A successful response's body is decoded into out unless it
+// is nil, and is consumed and closed either way, the returned response is
+// for its status and headers only. Failed requests return the response along
+// with the error when the api answered.
+func (c *Client) Do(ctx context.Context, method, path string, query url.Values, body io.Reader, out interface{}) (*http.Response, error) {
+	params := url.Values{}
+	for key, values := range query {
+		params[key] = values
+	}
+
+	req, err := c.newRequest(method, rawPath(path), params, body)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	defer res.Body.Close()
 
-	if res.StatusCode >= 200 && res.StatusCode < 400 {
-		if v != nil {
-			err = json.NewDecoder(res.Body).Decode(v)
+	return c.doResponse(withContext(req, ctx), out)
+}
+
+func (c *Client) do(req *http.Request, v interface{}) error {
+	_, err := c.doResponse(req, v)
+	return err
+}
+
+// doResponse makes the request like do and returns the response, its body is
+// read and closed already. Nothing is decoded from 304 responses.
+func (c *Client) doResponse(req *http.Request, v interface{}) (res *http.Response, err error) {
+	c.mu.RLock()
+	client, policy, tracer, metrics, useNumber := c.client, c.retryPolicy, c.tracer, c.metrics, c.useNumber
+	c.mu.RUnlock()
+
+	if decoder, ok := v.(numberDecoder); ok && useNumber {
+		decoder.useNumbers()
+	}
+
+	start := time.Now()
+	req, info, end := startSpan(tracer, req)
+	defer func() {
+		if res != nil {
+			info.StatusCode = res.StatusCode
+		}
+		end(err)
+
+		metrics.ObserveRequest(info.Method, info.PathTemplate, info.StatusCode, time.Since(start))
+	}()
+
+	for attempt := 1; ; attempt++ {
+		res, err := client.Do(req)
+		if err == nil && res.StatusCode >= 200 && res.StatusCode < 400 {
+			defer closeBody(res.Body)
+
+			if v != nil && res.StatusCode != http.StatusNotModified {
+				if decoder, ok := v.(bodyDecoder); ok {
+					err = decoder.decodeBody(res.Body)
+				} else {
+					dec := json.NewDecoder(res.Body)
+					if useNumber {
+						dec.UseNumber()
+					}
+					err = dec.Decode(v)
+				}
+				if err != nil {
+					return res, err
+				}
+			}
+
+			return res, nil
+		}
+
+		retry, wait := shouldRetry(policy, req, attempt, res, err)
+		if !retry {
+			if err != nil {
+				return nil, err
+			}
+
+			defer closeBody(res.Body)
+
+			// parse api response
+			return res, c.handleError(req, res)
+		}
+
+		if res != nil {
+			closeBody(res.Body)
+		}
+
+		select {
+		case <-time.After(wait):
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+
+		// rewind the request body for the next attempt
+		if req.GetBody != nil {
+			body, err := req.GetBody()
 			if err != nil {
-				return err
+				return nil, err
 			}
+
+			req.Body = body
 		}
+	}
+}
 
-		return nil
+// setVersionHeader sets the version header of updates to the entity's
+// version. Entities which are not created yet have no version, and are sent
+// without the header, also when they are created with a chosen id by a PUT.
+func setVersionHeader(req *http.Request, sys *Sys) {
+	if sys != nil && sys.Version > 0 {
+		req.Header.Set("X-Contentful-Version", strconv.Itoa(sys.Version))
 	}
+}
+
+// bodyDecoder is implemented by values which decode the response body
+// themselves, e.g. to process it while it is read
+type bodyDecoder interface {
+	decodeBody(body io.Reader) error
+}
 
-	// parse api response
-	apiError := c.handleError(req, res)
+// numberDecoder is implemented by values which decode numbers as json.Number
+// when told to, see WithUseNumber
+type numberDecoder interface {
+	useNumbers()
+}
 
-	// return apiError if it is not rate limit error
-	if _, ok := apiError.(RateLimitExceededError); !ok {
-		return apiError
+// getIfModified gets the resource at path into v unless it still has the
+// given etag. It returns the resource's current etag and whether it was not
+// modified, v is left untouched then.
+func (c *Client) getIfModified(ctx context.Context, path apiPath, etag string, v interface{}) (string, bool, error) {
+	req, err := c.newRequest(http.MethodGet, path, url.Values{}, nil)
+	if err != nil {
+		return "", false, err
 	}
 
-	resetHeader := res.Header.Get("x-contentful-ratelimit-reset")
+	req = withContext(req, ctx)
 
-	// return apiError if Ratelimit-Reset header is not presented
-	if resetHeader == "" {
-		return apiError
+	if etag != "" {
+		req.Header.Set("If-None-Match", etag)
 	}
 
-	// wait X-Contentful-Ratelimit-Reset amount of seconds
-	waitSeconds, err := strconv.Atoi(resetHeader)
+	res, err := c.doResponse(req, v)
 	if err != nil {
-		return apiError
+		return "", false, err
+	}
+
+	if res.StatusCode == http.StatusNotModified {
+		if current := res.Header.Get("ETag"); current != "" {
+			etag = current
+		}
+
+		return etag, true, nil
 	}
 
-	time.Sleep(time.Second * time.Duration(waitSeconds))
+	return res.Header.Get("ETag"), false, nil
+}
 
-	return c.do(req, v)
+// closeBody drains and closes a response body, so that the connection it was
+// read from can be reused
+func closeBody(body io.ReadCloser) {
+	io.Copy(ioutil.Discard, body)
+	body.Close()
+}
+
+func shouldRetry(policy RetryPolicy, req *http.Request, attempt int, res *http.Response, err error) (bool, time.Duration) {
+	if policy == nil || req.Context().Err() != nil {
+		return false, 0
+	}
+
+	// requests with a body which can not be rewound are sent only once
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false, 0
+	}
+
+	return policy.ShouldRetry(attempt, res, err)
 }
 
 func (c *Client) handleError(req *http.Request, res *http.Response) error {
-	if c.Debug == true {
+	c.mu.RLock()
+	debug := c.Debug
+	c.mu.RUnlock()
+
+	if debug == true {
 		dump, err := httputil.DumpResponse(res, true)
 		if err != nil {
 			log.Fatal(err)
 		}
 
-		fmt.Printf("%q", dump)
+		if c.logger != nil {
+			c.logger.Printf("%q", dump)
+		} else {
+			fmt.Printf("%q", dump)
+		}
 	}
 
 	var e ErrorResponse
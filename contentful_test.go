@@ -2,15 +2,19 @@ package contentful
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"log"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -188,6 +192,85 @@ func TestContentfulSetClient(t *testing.T) {
 	assert.Equal(newClient, cma.client)
 }
 
+func TestContentfulWithEnvironment(t *testing.T) {
+	assert := assert.New(t)
+
+	paths := make(chan string, 2)
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths <- r.URL.Path
+
+		w.WriteHeader(200)
+		fmt.Fprintln(w, readTestData("content_types.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma := NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	staging := cma.WithEnvironment("staging")
+	staging.SetOrganization(organizationID)
+
+	assert.Equal("master", cma.Environment)
+	assert.Equal("staging", staging.Environment)
+	assert.Equal(cma.client, staging.client)
+	assert.Equal("", cma.Headers["X-Contentful-Organization"])
+	assert.Equal(cma.Headers["Authorization"], staging.Headers["Authorization"])
+
+	_, err := staging.ContentTypes.List(spaceID).Next()
+	assert.Nil(err)
+	assert.Equal("/spaces/"+spaceID+"/environments/staging/content_types", <-paths)
+
+	_, err = cma.ContentTypes.List(spaceID).Next()
+	assert.Nil(err)
+	assert.Equal("/spaces/"+spaceID+"/environments/master/content_types", <-paths)
+}
+
+func TestContentfulConcurrentRequests(t *testing.T) {
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(200)
+		fmt.Fprintln(w, readTestData("space-1.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma := NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			switch i % 4 {
+			case 0:
+				cma.SetOrganization(organizationID)
+			case 1:
+				cma.SetEnvironment("master")
+			case 2:
+				cma.SetRetryPolicy(NewDefaultRetryPolicy())
+			case 3:
+				cma.WithEnvironment("staging").SetOrganization(organizationID)
+			}
+
+			space, err := cma.Spaces.Get("id1")
+			assert.Nil(err)
+			assert.Equal("id1", space.Sys.ID)
+		}(i)
+	}
+
+	wg.Wait()
+}
+
 func TestNewRequest(t *testing.T) {
 	setup()
 	defer teardown()
@@ -204,7 +287,7 @@ func TestNewRequest(t *testing.T) {
 	expectedURL.Path = path
 	expectedURL.RawQuery = query.Encode()
 
-	req, err := c.newRequest(method, path, query, nil)
+	req, err := c.newRequest(method, pathf(path), query, nil)
 	assert.Nil(err)
 	assert.Equal(req.Header.Get("Authorization"), "Bearer "+CMAToken)
 	assert.Equal(req.Header.Get("Content-Type"), "application/vnd.contentful.management.v1+json")
@@ -221,7 +304,7 @@ func TestNewRequest(t *testing.T) {
 		Age:  10,
 	}
 	body, _ := json.Marshal(bodyData)
-	req, err = c.newRequest(method, path, query, bytes.NewReader(body))
+	req, err = c.newRequest(method, pathf(path), query, bytes.NewReader(body))
 	assert.Nil(err)
 	assert.Equal(req.Header.Get("Authorization"), "Bearer "+CMAToken)
 	assert.Equal(req.Header.Get("Content-Type"), "application/vnd.contentful.management.v1+json")
@@ -234,6 +317,53 @@ func TestNewRequest(t *testing.T) {
 	assert.Equal(requestBody, bodyData)
 }
 
+func TestContentfulDo(t *testing.T) {
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("POST", r.Method)
+		assert.Equal("/spaces/"+spaceID+"/environments/staging/tags", r.URL.Path)
+		assert.Equal("bar", r.URL.Query().Get("foo"))
+		assert.Equal("Bearer "+CMAToken, r.Header.Get("Authorization"))
+
+		var payload map[string]interface{}
+		assert.Nil(json.NewDecoder(r.Body).Decode(&payload))
+		assert.Equal("nyan", payload["name"])
+
+		if r.URL.Query().Get("fail") != "" {
+			w.WriteHeader(404)
+			fmt.Fprintln(w, readTestData("error-notfound.json"))
+			return
+		}
+
+		w.Header().Set("X-Contentful-Request-Id", "request-id")
+		w.WriteHeader(201)
+		fmt.Fprintln(w, `{"sys": {"id": "nyan", "type": "Tag"}, "name": "nyan"}`)
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	cma = NewCMA(CMAToken, WithBaseURL(server.URL), WithRetryPolicy(nil))
+
+	// overrides stored in the context apply
+	ctx := WithRequestEnvironment(context.Background(), "staging")
+
+	var tag struct {
+		Sys  *Sys   `json:"sys"`
+		Name string `json:"name"`
+	}
+	res, err := cma.Do(ctx, "POST", "/spaces/"+spaceID+"/environments/master/tags", url.Values{"foo": {"bar"}}, strings.NewReader(`{"name": "nyan"}`), &tag)
+	assert.Nil(err)
+	assert.Equal(201, res.StatusCode)
+	assert.Equal("request-id", res.Header.Get("X-Contentful-Request-Id"))
+	assert.Equal("nyan", tag.Sys.ID)
+
+	res, err = cma.Do(ctx, "POST", "/spaces/"+spaceID+"/environments/master/tags", url.Values{"foo": {"bar"}, "fail": {"1"}}, strings.NewReader(`{"name": "nyan"}`), nil)
+	assert.NotNil(err)
+	assert.Equal(404, res.StatusCode)
+}
+
 func TestHandleError(t *testing.T) {
 	setup()
 	defer teardown()
@@ -257,7 +387,7 @@ func TestHandleError(t *testing.T) {
 	errResponseReader := bytes.NewReader(marshaled)
 	errResponseReadCloser := ioutil.NopCloser(errResponseReader)
 
-	req, _ := c.newRequest(method, path, query, nil)
+	req, _ := c.newRequest(method, pathf(path), query, nil)
 	responseHeaders := http.Header{}
 	responseHeaders.Add("X-Contentful-Request-Id", requestID)
 	res := &http.Response{
@@ -277,11 +407,11 @@ func TestHandleError(t *testing.T) {
 func TestBackoffForPerSecondLimiting(t *testing.T) {
 	var err error
 	assert := assert.New(t)
-	rateLimited := true
+	var rateLimited int32 = 1
 	waitSeconds := 2
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if rateLimited == true {
+		if atomic.LoadInt32(&rateLimited) == 1 {
 			w.Header().Set("X-Contentful-Request-Id", "request-id")
 			w.Header().Set("Content-Type", "application/vnd.contentful.management.v1+json")
 			w.Header().Set("X-Contentful-Ratelimit-Hour-Limit", "36000")
@@ -307,7 +437,7 @@ func TestBackoffForPerSecondLimiting(t *testing.T) {
 
 	go func() {
 		time.Sleep(time.Second * time.Duration(waitSeconds))
-		rateLimited = false
+		atomic.StoreInt32(&rateLimited, 0)
 	}()
 
 	space, err := cma.Spaces.Get("id1")
@@ -315,3 +445,44 @@ func TestBackoffForPerSecondLimiting(t *testing.T) {
 	assert.Equal(space.Name, "Contentful Example API")
 	assert.Equal(space.Sys.ID, "id1")
 }
+
+func TestContentfulReusesConnections(t *testing.T) {
+	assert := assert.New(t)
+
+	// the responses carry more data after the json than the decoder reads
+	padding := strings.Repeat(" ", 1024*1024)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/missing") {
+			w.WriteHeader(404)
+			fmt.Fprintln(w, readTestData("error-notfound.json")+padding)
+			return
+		}
+
+		fmt.Fprintln(w, readTestData("content_type.json")+padding)
+	})
+
+	var connections int32
+	server := httptest.NewUnstartedServer(handler)
+	server.Config.ConnState = func(conn net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			atomic.AddInt32(&connections, 1)
+		}
+	}
+	server.Start()
+	defer server.Close()
+
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+	cma.SetRetryPolicy(nil)
+
+	for i := 0; i < 5; i++ {
+		_, err := cma.ContentTypes.Get(spaceID, "63Vgs0BFK0USe4i2mQUGK6")
+		assert.Nil(err)
+
+		_, err = cma.ContentTypes.Get(spaceID, "missing")
+		assert.IsType(NotFoundError{}, err)
+	}
+
+	assert.Equal(int32(1), atomic.LoadInt32(&connections))
+}
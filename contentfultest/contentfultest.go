@@ -0,0 +1,160 @@
+// Package contentfultest provides helpers to test code using the contentful
+// client without a contentful space. The client's requests are recorded and
+// answered with canned responses, e.g.
+//
+//	cma, transport := contentfultest.NewTestClient(t, map[string]*contentfultest.Response{
+//		"GET /spaces/space-id/environments/master/entries/nyancat": {Body: `{"sys": {"id": "nyancat"}}`},
+//	})
+//
+//	entry, err := cma.Entries.Get("space-id", "nyancat")
+//	...
+//	requests := transport.Requests()
+package contentfultest
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sync"
+	"testing"
+
+	contentful "github.com/utilitywarehouse/contentful-go"
+)
+
+// Response model, a canned response. The status defaults to 200.
+type Response struct {
+	Status int
+	Header http.Header
+	Body   string
+}
+
+// Request model, a recorded request
+type Request struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Header http.Header
+	Body   []byte
+}
+
+// RecordingTransport is a http.RoundTripper which records the requests and
+// serves the canned responses keyed by method and path, e.g.
+// "GET /spaces/space-id/environments/master/entries". Requests without a
+// response are answered with a NotFound error, and fail the test if the
+// transport has one.
+type RecordingTransport struct {
+	mu        sync.Mutex
+	t         testing.TB
+	responses map[string]*Response
+	requests  []*Request
+}
+
+// NewRecordingTransport returns a transport serving the given responses
+func NewRecordingTransport(responses map[string]*Response) *RecordingTransport {
+	if responses == nil {
+		responses = map[string]*Response{}
+	}
+
+	return &RecordingTransport{
+		responses: responses,
+	}
+}
+
+// NewTestClient returns a CMA client whose requests are served by a
+// recording transport, which is returned as well. Retries are disabled.
+func NewTestClient(t testing.TB, responses map[string]*Response) (*contentful.Client, *RecordingTransport) {
+	transport := NewRecordingTransport(responses)
+	transport.t = t
+
+	client := contentful.NewCMA("test-token",
+		contentful.WithHTTPClient(&http.Client{Transport: transport}),
+		contentful.WithMaxRetries(0),
+	)
+
+	return client, transport
+}
+
+// Respond sets the response served for the given method and path
+func (transport *RecordingTransport) Respond(method, path string, response *Response) {
+	transport.mu.Lock()
+	defer transport.mu.Unlock()
+
+	transport.responses[method+" "+path] = response
+}
+
+// Requests returns the recorded requests in the order they were made
+func (transport *RecordingTransport) Requests() []*Request {
+	transport.mu.Lock()
+	defer transport.mu.Unlock()
+
+	requests := make([]*Request, len(transport.requests))
+	copy(requests, transport.requests)
+
+	return requests
+}
+
+// RoundTrip records the request and serves its canned response
+func (transport *RecordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	recorded := &Request{
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Query:  req.URL.Query(),
+		Header: req.Header.Clone(),
+	}
+
+	if req.Body != nil {
+		body, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		recorded.Body = body
+	}
+
+	key := req.Method + " " + req.URL.Path
+
+	transport.mu.Lock()
+	transport.requests = append(transport.requests, recorded)
+	response, ok := transport.responses[key]
+	transport.mu.Unlock()
+
+	if !ok {
+		if transport.t != nil {
+			transport.t.Errorf("contentfultest: unexpected request %s", key)
+		}
+
+		response = &Response{
+			Status: http.StatusNotFound,
+			Body:   fmt.Sprintf(`{"sys": {"type": "Error", "id": "NotFound"}, "message": "no response for %s"}`, key),
+		}
+	}
+
+	status := response.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+
+	header := http.Header{}
+	for key, values := range response.Header {
+		header[key] = values
+	}
+
+	if header.Get("Content-Type") == "" {
+		header.Set("Content-Type", "application/vnd.contentful.management.v1+json")
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader([]byte(response.Body))),
+		ContentLength: int64(len(response.Body)),
+		Request:       req,
+	}, nil
+}
@@ -0,0 +1,57 @@
+package contentfultest
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	contentful "github.com/utilitywarehouse/contentful-go"
+)
+
+func TestNewTestClient(t *testing.T) {
+	assert := assert.New(t)
+
+	cma, transport := NewTestClient(t, map[string]*Response{
+		"GET /spaces/space-id/environments/master/content_types/cat": {
+			Body: `{"sys": {"id": "cat", "version": 3}, "name": "Cat"}`,
+		},
+	})
+
+	transport.Respond("PUT", "/spaces/space-id/environments/master/content_types/cat", &Response{
+		Body: `{"sys": {"id": "cat", "version": 4}, "name": "Nyan Cat"}`,
+	})
+
+	ct, err := cma.ContentTypes.Get("space-id", "cat")
+	assert.Nil(err)
+	assert.Equal("Cat", ct.Name)
+
+	ct.Name = "Nyan Cat"
+	assert.Nil(cma.ContentTypes.Upsert("space-id", ct))
+	assert.Equal(4, ct.Sys.Version)
+
+	requests := transport.Requests()
+	assert.Equal(2, len(requests))
+	assert.Equal("GET", requests[0].Method)
+	assert.Equal("PUT", requests[1].Method)
+	assert.Equal("/spaces/space-id/environments/master/content_types/cat", requests[1].Path)
+	assert.Equal("3", requests[1].Header.Get("X-Contentful-Version"))
+	assert.Equal("Bearer test-token", requests[1].Header.Get("Authorization"))
+
+	var body map[string]interface{}
+	assert.Nil(json.Unmarshal(requests[1].Body, &body))
+	assert.Equal("Nyan Cat", body["name"])
+}
+
+func TestRecordingTransportNotFound(t *testing.T) {
+	assert := assert.New(t)
+
+	// without a test, unexpected requests don't fail
+	transport := NewRecordingTransport(nil)
+	cma, _ := NewTestClient(t, nil)
+	cma.SetHTTPClient(&http.Client{Transport: transport})
+
+	_, err := cma.ContentTypes.Get("space-id", "nyancat")
+	assert.IsType(contentful.NotFoundError{}, err)
+	assert.Equal(1, len(transport.Requests()))
+}
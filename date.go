@@ -0,0 +1,78 @@
+package contentful
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// dateLayouts are the formats the values of Date fields come in: date and
+// time with a time zone, date and time without one, and date only
+var dateLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04Z07:00",
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+// ContentfulDate model, the value of a Date field. It is marshaled as it was
+// unmarshaled, so that e.g. a date only value stays a date only value.
+type ContentfulDate struct {
+	t     time.Time
+	value string
+}
+
+// NewContentfulDate returns the date of the given time, with time and time
+// zone
+func NewContentfulDate(t time.Time) ContentfulDate {
+	return ContentfulDate{t: t, value: t.Format(time.RFC3339Nano)}
+}
+
+// ParseContentfulDate parses the value of a Date field. Dates without a time
+// zone are parsed as UTC.
+func ParseContentfulDate(value string) (ContentfulDate, error) {
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return ContentfulDate{t: t, value: value}, nil
+		}
+	}
+
+	return ContentfulDate{}, fmt.Errorf("value %q is not a date", value)
+}
+
+// Time returns the date as time.Time
+func (date ContentfulDate) Time() time.Time {
+	return date.t
+}
+
+// String returns the date as it was parsed
+func (date ContentfulDate) String() string {
+	if date.value == "" {
+		return date.t.Format(time.RFC3339Nano)
+	}
+
+	return date.value
+}
+
+// MarshalJSON for custom json marshaling
+func (date ContentfulDate) MarshalJSON() ([]byte, error) {
+	return json.Marshal(date.String())
+}
+
+// UnmarshalJSON for custom json unmarshaling
+func (date *ContentfulDate) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	parsed, err := ParseContentfulDate(value)
+	if err != nil {
+		return err
+	}
+
+	*date = parsed
+
+	return nil
+}
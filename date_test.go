@@ -0,0 +1,45 @@
+package contentful
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContentfulDate(t *testing.T) {
+	assert := assert.New(t)
+
+	tests := []struct {
+		value    string
+		expected time.Time
+	}{
+		{"2017-01-05", time.Date(2017, 1, 5, 0, 0, 0, 0, time.UTC)},
+		{"2017-01-05T14:30", time.Date(2017, 1, 5, 14, 30, 0, 0, time.UTC)},
+		{"2017-01-05T14:30:15", time.Date(2017, 1, 5, 14, 30, 15, 0, time.UTC)},
+		{"2017-01-05T14:30+01:00", time.Date(2017, 1, 5, 13, 30, 0, 0, time.UTC)},
+		{"2017-01-05T14:30:15+01:00", time.Date(2017, 1, 5, 13, 30, 15, 0, time.UTC)},
+		{"2017-01-05T14:30:15.250Z", time.Date(2017, 1, 5, 14, 30, 15, 250000000, time.UTC)},
+	}
+
+	for _, test := range tests {
+		var date ContentfulDate
+		err := json.Unmarshal([]byte(`"`+test.value+`"`), &date)
+		assert.Nil(err, test.value)
+		assert.True(test.expected.Equal(date.Time()), test.value)
+
+		// dates are marshaled in the format they came in
+		byteArray, err := json.Marshal(date)
+		assert.Nil(err)
+		assert.Equal(`"`+test.value+`"`, string(byteArray))
+	}
+
+	var date ContentfulDate
+	assert.NotNil(json.Unmarshal([]byte(`"yesterday"`), &date))
+	assert.NotNil(json.Unmarshal([]byte(`42`), &date))
+
+	byteArray, err := json.Marshal(NewContentfulDate(time.Date(2017, 1, 5, 14, 30, 0, 0, time.UTC)))
+	assert.Nil(err)
+	assert.Equal(`"2017-01-05T14:30:00Z"`, string(byteArray))
+}
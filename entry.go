@@ -2,21 +2,293 @@ package contentful
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
 	"strconv"
+	"sync"
 )
 
 // EntriesService service
 type EntriesService service
 
 // Entry model
+//
+// Fields hold a locale map per field, e.g. {"title": {"en-US": "..."}},
+// unless a locale is set on the entry. With a locale set, Fields hold the
+// values of that locale directly, e.g. {"title": "..."}, and are wrapped into
+// locale maps again on marshaling, along with the values of the other
+// locales the entry was read with. Fields which had no value of the locale
+// are left as locale maps. Updating an entry with a locale set fetches the
+// entry first, so that the values of the other locales are kept.
 type Entry struct {
-	locale string
-	Sys    *Sys `json:"sys"`
-	Fields map[string]interface{}
+	locale      string
+	localized   map[string]map[string]interface{}
+	unflattened map[string]bool
+	useNumber   bool
+	Sys         *Sys                   `json:"sys"`
+	Fields      map[string]interface{} `json:"fields"`
+}
+
+// SetLocale sets the locale of the entry's field values
+func (entry *Entry) SetLocale(code string) {
+	entry.locale = code
+	entry.localized = nil
+	entry.unflattened = nil
+}
+
+// Locale returns the locale of the entry's field values, it is empty when
+// fields hold locale maps
+func (entry *Entry) Locale() string {
+	return entry.locale
+}
+
+// MarshalJSON for custom json marshaling
+func (entry *Entry) MarshalJSON() ([]byte, error) {
+	fields := entry.Fields
+
+	if entry.locale != "" {
+		fields = entry.localizedFields(entry.locale)
+	}
+
+	return json.Marshal(&struct {
+		Sys    *Sys                   `json:"sys,omitempty"`
+		Fields map[string]interface{} `json:"fields"`
+	}{
+		Sys:    entry.Sys,
+		Fields: fields,
+	})
+}
+
+// localizedFields returns the fields of an entry of a single locale as
+// locale maps, holding the values under the given locale along with the
+// values of the other locales they were flattened from
+func (entry *Entry) localizedFields(code string) map[string]interface{} {
+	fields := map[string]interface{}{}
+
+	for key, value := range entry.Fields {
+		if entry.unflattened[key] {
+			if _, ok := value.(map[string]interface{}); ok {
+				fields[key] = value
+				continue
+			}
+		}
+
+		localized := map[string]interface{}{}
+		for locale, val := range entry.localized[key] {
+			localized[locale] = val
+		}
+		localized[code] = value
+
+		fields[key] = localized
+	}
+
+	return fields
+}
+
+// UnmarshalJSON for custom json unmarshaling
+func (entry *Entry) UnmarshalJSON(data []byte) error {
+	var payload struct {
+		Sys    *Sys                   `json:"sys"`
+		Fields map[string]interface{} `json:"fields"`
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if entry.useNumber {
+		dec.UseNumber()
+	}
+
+	if err := dec.Decode(&payload); err != nil {
+		return err
+	}
+
+	entry.Sys = payload.Sys
+	entry.Fields = payload.Fields
+	entry.flattenLocale()
+
+	return nil
+}
+
+func (entry *Entry) useNumbers() {
+	entry.useNumber = true
+}
+
+// flattenLocale replaces locale maps with the values of the entry's locale.
+// The locale maps are kept to marshal the values of the other locales along,
+// fields with no value of the locale are left as locale maps. Entries the
+// api has localized already, i.e. with the locale in their sys, hold the
+// values of the locale only.
+func (entry *Entry) flattenLocale() {
+	entry.localized = nil
+	entry.unflattened = nil
+
+	if entry.locale == "" || entry.Sys != nil && entry.Sys.Locale == entry.locale {
+		return
+	}
+
+	entry.localized = map[string]map[string]interface{}{}
+	entry.unflattened = map[string]bool{}
+
+	for key, value := range entry.Fields {
+		localized, ok := value.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		entry.localized[key] = localized
+
+		if val, ok := localized[entry.locale]; ok {
+			entry.Fields[key] = val
+		} else {
+			entry.unflattened[key] = true
+		}
+	}
+}
+
+// ExpandToLocale wraps every field value into a locale map holding the value
+// under the given locale, e.g. {"title": "..."} becomes
+// {"title": {"en-US": "..."}}. Values which already are locale maps holding
+// the locale are left as they are, so expanding twice is safe. Entries
+// collapsed with CollapseLocale get back the values of the other locales
+// as well.
+func (entry *Entry) ExpandToLocale(code string) {
+	if entry.locale != "" {
+		entry.Fields = entry.localizedFields(code)
+		entry.SetLocale("")
+		return
+	}
+
+	for key, value := range entry.Fields {
+		if localized, ok := value.(map[string]interface{}); ok {
+			if _, ok := localized[code]; ok {
+				continue
+			}
+		}
+
+		entry.Fields[key] = map[string]interface{}{
+			code: value,
+		}
+	}
+}
+
+// CollapseLocale replaces every locale map holding the given locale with
+// the locale's value, the reverse of ExpandToLocale. Locale maps without a
+// value of the locale are left as they are. The entry's locale is set to the
+// given locale, the values are marshaled as locale maps again, along with
+// the values of the other locales.
+func (entry *Entry) CollapseLocale(code string) {
+	entry.locale = code
+	entry.flattenLocale()
+}
+
+// SetLink sets the field's value of the given locale to a link to the entry
+// or asset with the given id, linkType is either "Entry" or "Asset"
+func (entry *Entry) SetLink(fieldID, locale, targetID string, linkType string) error {
+	if err := validateLinkType(linkType); err != nil {
+		return err
+	}
+
+	return entry.setLocalizedValue(fieldID, locale, NewLink(linkType, targetID))
+}
+
+// SetLinks sets the field's value of the given locale to links to the
+// entries or assets with the given ids, linkType is either "Entry" or "Asset"
+func (entry *Entry) SetLinks(fieldID, locale string, targets []string, linkType string) error {
+	if err := validateLinkType(linkType); err != nil {
+		return err
+	}
+
+	return entry.setLocalizedValue(fieldID, locale, NewLinks(linkType, targets...))
+}
+
+// GetLocation returns the field's value of the given locale as a location
+func (entry *Entry) GetLocation(fieldID, locale string) (*Location, error) {
+	value, err := entry.localizedValue(fieldID, locale)
+	if err != nil {
+		return nil, err
+	}
+
+	byteArray, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var location Location
+	if err := json.Unmarshal(byteArray, &location); err != nil {
+		return nil, fmt.Errorf("field %s is not a location: %w", fieldID, err)
+	}
+
+	return &location, nil
+}
+
+// SetLocation sets the field's value of the given locale to the location
+func (entry *Entry) SetLocation(fieldID, locale string, location *Location) error {
+	return entry.setLocalizedValue(fieldID, locale, location)
+}
+
+func validateLinkType(linkType string) error {
+	if linkType != LinkTypeEntry && linkType != LinkTypeAsset {
+		return fmt.Errorf("link type must be Entry or Asset, got %q", linkType)
+	}
+
+	return nil
+}
+
+// setLocalizedValue sets the field's value of the given locale, directly
+// when the entry holds the values of that locale only
+func (entry *Entry) setLocalizedValue(fieldID, locale string, value interface{}) error {
+	if entry.Fields == nil {
+		entry.Fields = map[string]interface{}{}
+	}
+
+	if entry.locale != "" {
+		if entry.locale != locale {
+			return fmt.Errorf("entry holds the values of locale %q only", entry.locale)
+		}
+
+		entry.Fields[fieldID] = value
+		delete(entry.unflattened, fieldID)
+		return nil
+	}
+
+	localized, ok := entry.Fields[fieldID].(map[string]interface{})
+	if !ok {
+		localized = map[string]interface{}{}
+		entry.Fields[fieldID] = localized
+	}
+
+	localized[locale] = value
+
+	return nil
+}
+
+// localizedValue returns the field's value of the given locale
+func (entry *Entry) localizedValue(fieldID, locale string) (interface{}, error) {
+	if entry.locale != "" {
+		if entry.locale != locale {
+			return nil, fmt.Errorf("entry holds the values of locale %q only", entry.locale)
+		}
+
+		value, ok := entry.Fields[fieldID]
+		if !ok {
+			return nil, fmt.Errorf("entry has no field %s", fieldID)
+		}
+
+		return value, nil
+	}
+
+	localized, ok := entry.Fields[fieldID].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("entry has no field %s", fieldID)
+	}
+
+	value, ok := localized[locale]
+	if !ok {
+		return nil, fmt.Errorf("field %s has no value for locale %q", fieldID, locale)
+	}
+
+	return value, nil
 }
 
 // GetVersion returns entity version
@@ -29,37 +301,62 @@ func (entry *Entry) GetVersion() int {
 	return version
 }
 
-// GetEntryKey returns the entry's keys
+// Status returns the publishing state of the entry
+func (entry *Entry) Status() EntryStatus {
+	return entry.Sys.status()
+}
+
+// GetEntryKey returns the entry's keys. The entry's content type is fetched
+// once and cached, call InvalidateContentTypeCache after changing it.
 func (service *EntriesService) GetEntryKey(entry *Entry, key string) (*EntryField, error) {
 	ef := EntryField{
 		value: entry.Fields[key],
 	}
 
-	col, err := service.c.ContentTypes.List(entry.Sys.Space.Sys.ID).Next()
+	ct, err := service.contentType(entry.Sys.Space.Sys.ID, entry.Sys.ContentType.Sys.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	for _, ct := range col.ToContentType() {
-		if ct.Sys.ID != entry.Sys.ContentType.Sys.ID {
+	for _, field := range ct.Fields {
+		if field.ID != key {
 			continue
 		}
 
-		for _, field := range ct.Fields {
-			if field.ID != key {
-				continue
-			}
-
-			ef.dataType = field.Type
-		}
+		ef.dataType = field.Type
 	}
 
 	return &ef, nil
 }
 
+// InvalidateContentTypeCache drops the content types cached by GetEntryKey
+func (service *EntriesService) InvalidateContentTypeCache() {
+	service.c.contentTypes.clear()
+}
+
+// contentType returns the content type with the given id, fetching it unless
+// it is cached
+func (service *EntriesService) contentType(spaceID, contentTypeID string) (*ContentType, error) {
+	environment := service.c.environment()
+	key := contentTypeCacheKey(spaceID, environment, contentTypeID)
+
+	if ct, ok := service.c.contentTypes.get(key); ok {
+		return ct, nil
+	}
+
+	ct, err := service.c.ContentTypes.Get(spaceID, contentTypeID)
+	if err != nil {
+		return nil, err
+	}
+
+	service.c.contentTypes.set(key, ct)
+
+	return ct, nil
+}
+
 // List returns entries collection
 func (service *EntriesService) List(spaceID string) *Collection {
-	path := fmt.Sprintf("/spaces/%s/environments/%s/entries", spaceID, service.c.Environment)
+	path := service.c.envPath(spaceID, "/entries")
 
 	req, err := service.c.newRequest(http.MethodGet, path, nil, nil)
 	if err != nil {
@@ -75,7 +372,16 @@ func (service *EntriesService) List(spaceID string) *Collection {
 
 // Get returns a single entry
 func (service *EntriesService) Get(spaceID, entryID string) (*Entry, error) {
-	path := fmt.Sprintf("/spaces/%s/entries/%s", spaceID, entryID)
+	return service.get(context.Background(), spaceID, entryID)
+}
+
+// GetContext returns a single entry like Get, the request is made with ctx
+func (service *EntriesService) GetContext(ctx context.Context, spaceID, entryID string) (*Entry, error) {
+	return service.get(ctx, spaceID, entryID)
+}
+
+func (service *EntriesService) get(ctx context.Context, spaceID, entryID string) (*Entry, error) {
+	path := service.c.envPath(spaceID, "/entries/%s", entryID)
 	query := url.Values{}
 	method := "GET"
 
@@ -85,37 +391,109 @@ func (service *EntriesService) Get(spaceID, entryID string) (*Entry, error) {
 	}
 
 	var entry Entry
-	if ok := service.c.do(req, &entry); ok != nil {
+	if err := service.c.do(withContext(req, ctx), &entry); err != nil {
 		return nil, err
 	}
 
-	return &entry, err
+	return &entry, nil
 }
 
-// Upsert updates or creates a new entry
-func (service *EntriesService) Upsert(spaceID string, entry *Entry) error {
-	fields := map[string]interface{}{
-		"fields": entry.Fields,
-	}
+// GetLocalized returns a single entry holding the values of the given locale,
+// entry.Fields["title"] is then the title itself rather than a locale map.
+// The locale "*" returns the values of all locales as locale maps.
+func (service *EntriesService) GetLocalized(ctx context.Context, spaceID, entryID, locale string) (*Entry, error) {
+	path := service.c.envPath(spaceID, "/entries/%s", entryID)
+	query := url.Values{}
+	query.Set("locale", locale)
+	method := "GET"
 
-	bytesArray, err := json.Marshal(fields)
+	req, err := service.c.newRequest(method, path, query, nil)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	var entry Entry
+	if locale != "*" {
+		entry.locale = locale
+	}
+
+	if err := service.c.do(withContext(req, ctx), &entry); err != nil {
+		return nil, err
 	}
 
+	return &entry, nil
+}
+
+// GetIfModified returns the entry unless it still has the given etag, and a
+// nil entry with notModified set when the entry is unchanged. The entry's
+// current etag is returned as well, it is the etag to pass to the next call,
+// e.g. of a poller starting with an empty etag.
+func (service *EntriesService) GetIfModified(ctx context.Context, spaceID, entryID, etag string) (entry *Entry, currentETag string, notModified bool, err error) {
+	path := service.c.envPath(spaceID, "/entries/%s", entryID)
+
+	var fetched Entry
+	currentETag, notModified, err = service.c.getIfModified(ctx, path, etag, &fetched)
+	if err != nil || notModified {
+		return nil, currentETag, notModified, err
+	}
+
+	return &fetched, currentETag, false, nil
+}
+
+// Upsert updates or creates a new entry. Entries which have been created
+// before, i.e. have `Sys.CreatedAt` set, are updated, others are created
+// with the content type of `entry.Sys.ContentType` and, when set, the id of
+// `entry.Sys.ID`.
+func (service *EntriesService) Upsert(spaceID string, entry *Entry) error {
+	return service.upsert(context.Background(), spaceID, entry)
+}
+
+// UpsertContext updates or creates the entry like Upsert, the requests are
+// made with ctx
+func (service *EntriesService) UpsertContext(ctx context.Context, spaceID string, entry *Entry) error {
+	return service.upsert(ctx, spaceID, entry)
+}
+
+func (service *EntriesService) upsert(ctx context.Context, spaceID string, entry *Entry) error {
 	// Creating/updating an entry requires a content type to be provided
-	if entry.Sys.ContentType == nil {
-		return fmt.Errorf("creating/updating an entry requires a content type")
+	if entry.Sys == nil || entry.Sys.ContentType == nil || entry.Sys.ContentType.Sys == nil {
+		return fmt.Errorf("entry.Sys must be set with a ContentType before upsert")
 	}
 
-	var path string
+	if entry.Sys.CreatedAt != "" {
+		return service.update(ctx, spaceID, entry)
+	}
+
+	return service.create(ctx, spaceID, entry.Sys.ContentType.Sys.ID, entry)
+}
+
+// Create creates a new entry of the given content type, the entry does not
+// need a content type link in its Sys. The entry is POSTed unless
+// `entry.Sys.ID` is set: the api creates entries with a caller chosen id
+// with a PUT to the id instead, which fails with a VersionMismatchError when
+// the entry exists already.
+func (service *EntriesService) Create(ctx context.Context, spaceID, contentTypeID string, entry *Entry) error {
+	return service.create(ctx, spaceID, contentTypeID, entry)
+}
+
+func (service *EntriesService) create(ctx context.Context, spaceID, contentTypeID string, entry *Entry) error {
+	if contentTypeID == "" {
+		return fmt.Errorf("creating an entry requires a content type id")
+	}
+
+	bytesArray, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	var path apiPath
 	var method string
 
-	if entry.Sys != nil && entry.Sys.CreatedAt != "" {
-		path = fmt.Sprintf("/spaces/%s/environments/%s/entries/%s", spaceID, service.c.Environment, entry.Sys.ID)
+	if entry.Sys != nil && entry.Sys.ID != "" {
+		path = service.c.envPath(spaceID, "/entries/%s", entry.Sys.ID)
 		method = http.MethodPut
 	} else {
-		path = fmt.Sprintf("/spaces/%s/environments/%s/entries", spaceID, service.c.Environment)
+		path = service.c.envPath(spaceID, "/entries")
 		method = http.MethodPost
 	}
 
@@ -124,16 +502,125 @@ func (service *EntriesService) Upsert(spaceID string, entry *Entry) error {
 		return err
 	}
 
-	version := strconv.Itoa(entry.Sys.Version)
-	req.Header.Set("X-Contentful-Version", version)
-	req.Header.Set("X-Contentful-Content-Type", entry.Sys.ContentType.Sys.ID)
+	req = withContext(req, ctx)
+	req.Header.Set("X-Contentful-Content-Type", contentTypeID)
 
 	return service.c.do(req, entry)
 }
 
-// Delete the entry
-func (service *EntriesService) Delete(spaceID string, entryID string) error {
-	path := fmt.Sprintf("/spaces/%s/entries/%s", spaceID, entryID)
+// Update updates an existing entry, the entry's version is sent along to
+// detect conflicting updates
+func (service *EntriesService) Update(ctx context.Context, spaceID string, entry *Entry) error {
+	return service.update(ctx, spaceID, entry)
+}
+
+func (service *EntriesService) update(ctx context.Context, spaceID string, entry *Entry) error {
+	if entry.Sys == nil || entry.Sys.ID == "" {
+		return fmt.Errorf("updating an entry requires an entry id")
+	}
+
+	var body interface{} = entry
+	if entry.locale != "" {
+		merged, err := service.mergeLocales(ctx, spaceID, entry)
+		if err != nil {
+			return err
+		}
+
+		body = merged
+	}
+
+	bytesArray, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	path := service.c.envPath(spaceID, "/entries/%s", entry.Sys.ID)
+	method := http.MethodPut
+
+	req, err := service.c.newRequest(method, path, nil, bytes.NewReader(bytesArray))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("X-Contentful-Version", strconv.Itoa(entry.GetVersion()))
+
+	if entry.Sys.ContentType != nil && entry.Sys.ContentType.Sys != nil {
+		req.Header.Set("X-Contentful-Content-Type", entry.Sys.ContentType.Sys.ID)
+	}
+
+	return service.c.do(withContext(req, ctx), entry)
+}
+
+// mergeLocales returns the stored entry with the values of the given entry
+// of a single locale, as an update replaces the values of every locale
+func (service *EntriesService) mergeLocales(ctx context.Context, spaceID string, entry *Entry) (*Entry, error) {
+	current, err := service.get(ctx, spaceID, entry.Sys.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := current.Fields
+	if fields == nil {
+		fields = map[string]interface{}{}
+	}
+
+	for key, value := range entry.localizedFields(entry.locale) {
+		merged, ok := fields[key].(map[string]interface{})
+		if !ok {
+			merged = map[string]interface{}{}
+		}
+
+		for locale, val := range value.(map[string]interface{}) {
+			merged[locale] = val
+		}
+
+		fields[key] = merged
+	}
+
+	return &Entry{Sys: entry.Sys, Fields: fields}, nil
+}
+
+// UpdateField sets the value of a single field of the given locale. The
+// entry is fetched, changed and updated with the version it was fetched at.
+// When the entry is changed in between, and the update fails with a
+// VersionMismatchError, it is fetched and updated once more.
+func (service *EntriesService) UpdateField(ctx context.Context, spaceID, entryID, fieldID, locale string, value interface{}) error {
+	var err error
+	for attempt := 0; attempt < 2; attempt++ {
+		var entry *Entry
+		entry, err = service.get(ctx, spaceID, entryID)
+		if err != nil {
+			return err
+		}
+
+		if err := entry.setLocalizedValue(fieldID, locale, value); err != nil {
+			return err
+		}
+
+		err = service.update(ctx, spaceID, entry)
+		if _, ok := err.(VersionMismatchError); !ok {
+			return err
+		}
+	}
+
+	return err
+}
+
+// Delete the entry. Without a version the delete is not checked against
+// concurrent changes: it deletes the entry even if another process has just
+// modified it. Pass the version the entry was fetched at, or use DeleteEntry,
+// to have the delete fail with a VersionMismatchError instead.
+func (service *EntriesService) Delete(spaceID string, entryID string, version ...int) error {
+	return service.delete(context.Background(), spaceID, entryID, version...)
+}
+
+// DeleteContext deletes the entry like Delete, the request is made with ctx
+func (service *EntriesService) DeleteContext(ctx context.Context, spaceID string, entryID string, version ...int) error {
+	return service.delete(ctx, spaceID, entryID, version...)
+}
+
+func (service *EntriesService) delete(ctx context.Context, spaceID string, entryID string, version ...int) error {
+	path := service.c.envPath(spaceID, "/entries/%s", entryID)
 	method := "DELETE"
 
 	req, err := service.c.newRequest(method, path, nil, nil)
@@ -141,15 +628,24 @@ func (service *EntriesService) Delete(spaceID string, entryID string) error {
 		return err
 	}
 
-	return service.c.do(req, nil)
+	if len(version) > 0 {
+		req.Header.Set("X-Contentful-Version", strconv.Itoa(version[0]))
+	}
+
+	return service.c.do(withContext(req, ctx), nil)
 }
 
-// Publish the entry
-func (service *EntriesService) Publish(spaceID string, entry *Entry) error {
-	path := fmt.Sprintf("/spaces/%s/entries/%s/published", spaceID, entry.Sys.ID)
-	method := "PUT"
+// DeleteEntry deletes the entry, sending its version so that the delete fails
+// with a VersionMismatchError when the entry has been changed since it was
+// fetched
+func (service *EntriesService) DeleteEntry(ctx context.Context, spaceID string, entry *Entry) error {
+	if entry.Sys == nil || entry.Sys.ID == "" {
+		return fmt.Errorf("deleting an entry requires an entry id")
+	}
 
-	req, err := service.c.newRequest(method, path, nil, nil)
+	path := service.c.envPath(spaceID, "/entries/%s", entry.Sys.ID)
+
+	req, err := service.c.newRequest(http.MethodDelete, path, nil, nil)
 	if err != nil {
 		return err
 	}
@@ -157,12 +653,34 @@ func (service *EntriesService) Publish(spaceID string, entry *Entry) error {
 	version := strconv.Itoa(entry.Sys.Version)
 	req.Header.Set("X-Contentful-Version", version)
 
-	return service.c.do(req, nil)
+	return service.c.do(withContext(req, ctx), nil)
+}
+
+// Publish the entry. The entry is updated with the sys of its published
+// version.
+func (service *EntriesService) Publish(spaceID string, entry *Entry) error {
+	return service.publish(context.Background(), spaceID, entry)
+}
+
+// PublishContext publishes the entry like Publish, the request is made with
+// ctx
+func (service *EntriesService) PublishContext(ctx context.Context, spaceID string, entry *Entry) error {
+	return service.publish(ctx, spaceID, entry)
 }
 
 // Unpublish the entry
 func (service *EntriesService) Unpublish(spaceID string, entry *Entry) error {
-	path := fmt.Sprintf("/spaces/%s/entries/%s/published", spaceID, entry.Sys.ID)
+	return service.unpublish(context.Background(), spaceID, entry)
+}
+
+// UnpublishContext unpublishes the entry like Unpublish, the request is made
+// with ctx
+func (service *EntriesService) UnpublishContext(ctx context.Context, spaceID string, entry *Entry) error {
+	return service.unpublish(ctx, spaceID, entry)
+}
+
+func (service *EntriesService) unpublish(ctx context.Context, spaceID string, entry *Entry) error {
+	path := service.c.envPath(spaceID, "/entries/%s/published", entry.Sys.ID)
 	method := "DELETE"
 
 	req, err := service.c.newRequest(method, path, nil, nil)
@@ -173,5 +691,160 @@ func (service *EntriesService) Unpublish(spaceID string, entry *Entry) error {
 	version := strconv.Itoa(entry.Sys.Version)
 	req.Header.Set("X-Contentful-Version", version)
 
-	return service.c.do(req, nil)
+	return service.c.do(withContext(req, ctx), nil)
+}
+
+// ForceDelete deletes the entry, unpublishing it first when it is published.
+// The version of the unpublished entry is sent with the delete, so the
+// delete fails when the entry is changed in between.
+func (service *EntriesService) ForceDelete(ctx context.Context, spaceID string, entry *Entry) error {
+	if entry.Sys == nil || entry.Sys.ID == "" {
+		return fmt.Errorf("deleting an entry requires an entry id")
+	}
+
+	if entry.Sys.isPublished() {
+		path := service.c.envPath(spaceID, "/entries/%s/published", entry.Sys.ID)
+
+		req, err := service.c.newRequest(http.MethodDelete, path, nil, nil)
+		if err != nil {
+			return err
+		}
+
+		req.Header.Set("X-Contentful-Version", strconv.Itoa(entry.Sys.Version))
+
+		var unpublished Entry
+		if err := service.c.do(withContext(req, ctx), &unpublished); err != nil {
+			return err
+		}
+
+		if unpublished.Sys != nil {
+			entry.Sys = unpublished.Sys
+		}
+	}
+
+	path := service.c.envPath(spaceID, "/entries/%s", entry.Sys.ID)
+
+	req, err := service.c.newRequest(http.MethodDelete, path, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("X-Contentful-Version", strconv.Itoa(entry.Sys.Version))
+
+	return service.c.do(withContext(req, ctx), nil)
+}
+
+// PublishAll publishes the entries with up to `concurrency` requests at a
+// time. A failed entry does not stop the others, the errors are returned
+// keyed by entry id. Entries are updated with the sys of their published
+// version. Entries not yet published when ctx is done fail with the
+// context's error.
+func (service *EntriesService) PublishAll(ctx context.Context, spaceID string, entries []*Entry, concurrency int) ([]*Entry, map[string]error) {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	errs := make([]error, len(entries))
+	indexes := make(chan int)
+
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for index := range indexes {
+				errs[index] = service.publish(ctx, spaceID, entries[index])
+			}
+		}()
+	}
+
+dispatch:
+	for index := range entries {
+		select {
+		case indexes <- index:
+		case <-ctx.Done():
+			for ; index < len(entries); index++ {
+				errs[index] = ctx.Err()
+			}
+			break dispatch
+		}
+	}
+
+	close(indexes)
+	wg.Wait()
+
+	published := []*Entry{}
+	failed := map[string]error{}
+
+	for index, entry := range entries {
+		if errs[index] == nil {
+			published = append(published, entry)
+			continue
+		}
+
+		id := fmt.Sprintf("#%d", index)
+		if entry.Sys != nil && entry.Sys.ID != "" {
+			id = entry.Sys.ID
+		}
+
+		failed[id] = errs[index]
+	}
+
+	return published, failed
+}
+
+func (service *EntriesService) publish(ctx context.Context, spaceID string, entry *Entry) error {
+	if entry.Sys == nil || entry.Sys.ID == "" {
+		return fmt.Errorf("publishing an entry requires an entry id")
+	}
+
+	path := service.c.envPath(spaceID, "/entries/%s/published", entry.Sys.ID)
+	method := "PUT"
+
+	req, err := service.c.newRequest(method, path, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	version := strconv.Itoa(entry.Sys.Version)
+	req.Header.Set("X-Contentful-Version", version)
+
+	var published Entry
+	if err := service.c.do(withContext(req, ctx), &published); err != nil {
+		return err
+	}
+
+	if published.Sys != nil {
+		entry.Sys = published.Sys
+	}
+
+	return nil
+}
+
+// CreateIdempotent creates an entry with the id derived from the given
+// idempotency key, see IdempotentID. When the entry exists already, e.g.
+// because the create is replayed after its response got lost, the existing
+// entry is fetched into `entry` instead of creating a duplicate.
+func (service *EntriesService) CreateIdempotent(ctx context.Context, spaceID, contentTypeID, key string, entry *Entry) error {
+	if entry.Sys == nil {
+		entry.Sys = &Sys{}
+	}
+	entry.Sys.ID = IdempotentID(key)
+
+	err := service.create(ctx, spaceID, contentTypeID, entry)
+	if _, ok := err.(VersionMismatchError); !ok {
+		return err
+	}
+
+	existing, err := service.get(ctx, spaceID, entry.Sys.ID)
+	if err != nil {
+		return err
+	}
+
+	entry.Sys = existing.Sys
+	entry.Fields = existing.Fields
+	entry.flattenLocale()
+
+	return nil
 }
@@ -1,6 +1,11 @@
 package contentful
 
-import "reflect"
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+)
 
 // EntryField model
 type EntryField struct {
@@ -26,7 +31,12 @@ func (ef *EntryField) LString(locale string) string {
 
 // Integer converts interface to integer
 func (ef *EntryField) Integer() int {
-	return int(ef.value.(float64))
+	value, ok := intValue(ef.value)
+	if !ok {
+		panic(fmt.Sprintf("value %v is not a number", ef.value))
+	}
+
+	return value
 }
 
 // LInteger converts interface to integer
@@ -34,7 +44,12 @@ func (ef *EntryField) LInteger(locale string) int {
 	m := ef.value.(map[string]interface{})
 
 	if val, ok := m[locale]; ok {
-		return int(val.(float64))
+		value, ok := intValue(val)
+		if !ok {
+			panic(fmt.Sprintf("value %v is not a number", val))
+		}
+
+		return value
 	}
 
 	panic("no such a locale")
@@ -157,3 +172,159 @@ func (ef *EntryField) LEntry(locale string) *Entry {
 	// entry, _ := ef.space.GetEntries().Get(ef.LLinkID(locale))
 	return &Entry{}
 }
+
+// checkType returns an error unless the field is of one of the given types
+func (ef *EntryField) checkType(target string, types ...string) error {
+	for _, dataType := range types {
+		if ef.dataType == dataType {
+			return nil
+		}
+	}
+
+	if ef.dataType == "" {
+		return fmt.Errorf("field of unknown type can not be read as %s", target)
+	}
+
+	return fmt.Errorf("field of type %s can not be read as %s", ef.dataType, target)
+}
+
+// AsString returns the value of a Symbol or Text field
+func (ef *EntryField) AsString() (string, error) {
+	if err := ef.checkType("string", FieldTypeSymbol, FieldTypeText); err != nil {
+		return "", err
+	}
+
+	value, ok := ef.value.(string)
+	if !ok {
+		return "", fmt.Errorf("value %v is not a string", ef.value)
+	}
+
+	return value, nil
+}
+
+// AsInt returns the value of an Integer field
+func (ef *EntryField) AsInt() (int, error) {
+	if err := ef.checkType("int", FieldTypeInteger); err != nil {
+		return 0, err
+	}
+
+	value, ok := intValue(ef.value)
+	if !ok {
+		return 0, fmt.Errorf("value %v is not a number", ef.value)
+	}
+
+	return value, nil
+}
+
+// AsFloat returns the value of a Number or Integer field
+func (ef *EntryField) AsFloat() (float64, error) {
+	if err := ef.checkType("float", FieldTypeNumber, FieldTypeInteger); err != nil {
+		return 0, err
+	}
+
+	value, ok := floatValue(ef.value)
+	if !ok {
+		return 0, fmt.Errorf("value %v is not a number", ef.value)
+	}
+
+	return value, nil
+}
+
+// intValue returns the integer of a number decoded as float64 or, when the
+// client uses json.Number, as json.Number without losing precision
+func intValue(v interface{}) (int, bool) {
+	switch value := v.(type) {
+	case float64:
+		return int(value), true
+	case json.Number:
+		i, err := value.Int64()
+		if err != nil {
+			f, err := value.Float64()
+			return int(f), err == nil
+		}
+		return int(i), true
+	}
+
+	return 0, false
+}
+
+// floatValue returns the float of a number decoded as float64 or json.Number
+func floatValue(v interface{}) (float64, bool) {
+	switch value := v.(type) {
+	case float64:
+		return value, true
+	case json.Number:
+		f, err := value.Float64()
+		return f, err == nil
+	}
+
+	return 0, false
+}
+
+// AsBool returns the value of a Boolean field
+func (ef *EntryField) AsBool() (bool, error) {
+	if err := ef.checkType("bool", FieldTypeBoolean); err != nil {
+		return false, err
+	}
+
+	value, ok := ef.value.(bool)
+	if !ok {
+		return false, fmt.Errorf("value %v is not a boolean", ef.value)
+	}
+
+	return value, nil
+}
+
+// AsTime returns the value of a Date field. Dates without a time zone are
+// returned in UTC.
+func (ef *EntryField) AsTime() (time.Time, error) {
+	if err := ef.checkType("time", FieldTypeDate); err != nil {
+		return time.Time{}, err
+	}
+
+	value, ok := ef.value.(string)
+	if !ok {
+		return time.Time{}, fmt.Errorf("value %v is not a date", ef.value)
+	}
+
+	date, err := ParseContentfulDate(value)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return date.Time(), nil
+}
+
+// AsLinks returns the links of a Link field, or of an Array field of links
+func (ef *EntryField) AsLinks() ([]Link, error) {
+	if err := ef.checkType("links", FieldTypeLink, FieldTypeArray); err != nil {
+		return nil, err
+	}
+
+	byteArray, err := json.Marshal(ef.value)
+	if err != nil {
+		return nil, err
+	}
+
+	if ef.dataType == FieldTypeLink {
+		var link Link
+		if err := json.Unmarshal(byteArray, &link); err != nil || link.Sys == nil || link.Sys.Type != "Link" {
+			return nil, fmt.Errorf("value %s is not a link", byteArray)
+		}
+
+		return []Link{link}, nil
+	}
+
+	var links []Link
+	if err := json.Unmarshal(byteArray, &links); err != nil {
+		return nil, fmt.Errorf("value %s is not an array of links", byteArray)
+	}
+
+	for _, link := range links {
+		if link.Sys == nil || link.Sys.Type != "Link" {
+			return nil, fmt.Errorf("value %s is not an array of links", byteArray)
+		}
+	}
+
+	return links, nil
+}
@@ -0,0 +1,97 @@
+package contentful
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEntryFieldTypedAccessors(t *testing.T) {
+	assert := assert.New(t)
+
+	var links interface{}
+	err := json.Unmarshal([]byte(`[
+		{"sys": {"type": "Link", "linkType": "Asset", "id": "nyancat"}},
+		{"sys": {"type": "Link", "linkType": "Asset", "id": "happycat"}}
+	]`), &links)
+	assert.Nil(err)
+
+	var link interface{}
+	err = json.Unmarshal([]byte(`{"sys": {"type": "Link", "linkType": "Entry", "id": "nyancat"}}`), &link)
+	assert.Nil(err)
+
+	s, err := (&EntryField{value: "nyan", dataType: FieldTypeSymbol}).AsString()
+	assert.Nil(err)
+	assert.Equal("nyan", s)
+
+	i, err := (&EntryField{value: float64(42), dataType: FieldTypeInteger}).AsInt()
+	assert.Nil(err)
+	assert.Equal(42, i)
+
+	f, err := (&EntryField{value: 4.2, dataType: FieldTypeNumber}).AsFloat()
+	assert.Nil(err)
+	assert.Equal(4.2, f)
+
+	// numbers of clients using json.Number
+	i, err = (&EntryField{value: json.Number("9007199254740993"), dataType: FieldTypeInteger}).AsInt()
+	assert.Nil(err)
+	assert.Equal(9007199254740993, i)
+
+	f, err = (&EntryField{value: json.Number("4.2"), dataType: FieldTypeNumber}).AsFloat()
+	assert.Nil(err)
+	assert.Equal(4.2, f)
+
+	b, err := (&EntryField{value: true, dataType: FieldTypeBoolean}).AsBool()
+	assert.Nil(err)
+	assert.True(b)
+
+	d, err := (&EntryField{value: "2017-01-05T14:30:00+01:00", dataType: FieldTypeDate}).AsTime()
+	assert.Nil(err)
+	assert.True(d.Equal(time.Date(2017, 1, 5, 13, 30, 0, 0, time.UTC)))
+
+	d, err = (&EntryField{value: "2017-01-05T14:30", dataType: FieldTypeDate}).AsTime()
+	assert.Nil(err)
+	assert.Equal(time.Date(2017, 1, 5, 14, 30, 0, 0, time.UTC), d)
+
+	d, err = (&EntryField{value: "2017-01-05", dataType: FieldTypeDate}).AsTime()
+	assert.Nil(err)
+	assert.Equal(time.Date(2017, 1, 5, 0, 0, 0, 0, time.UTC), d)
+
+	l, err := (&EntryField{value: link, dataType: FieldTypeLink}).AsLinks()
+	assert.Nil(err)
+	assert.Equal([]Link{*NewEntryLink("nyancat")}, l)
+
+	l, err = (&EntryField{value: links, dataType: FieldTypeArray}).AsLinks()
+	assert.Nil(err)
+	assert.Equal([]Link{*NewAssetLink("nyancat"), *NewAssetLink("happycat")}, l)
+}
+
+func TestEntryFieldTypedAccessorsMismatch(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := (&EntryField{value: float64(42), dataType: FieldTypeInteger}).AsString()
+	assert.EqualError(err, "field of type Integer can not be read as string")
+
+	_, err = (&EntryField{value: "nyan"}).AsString()
+	assert.EqualError(err, "field of unknown type can not be read as string")
+
+	_, err = (&EntryField{value: 4.2, dataType: FieldTypeNumber}).AsInt()
+	assert.NotNil(err)
+
+	_, err = (&EntryField{value: "true", dataType: FieldTypeSymbol}).AsBool()
+	assert.NotNil(err)
+
+	_, err = (&EntryField{value: "nyan", dataType: FieldTypeText}).AsFloat()
+	assert.NotNil(err)
+
+	_, err = (&EntryField{value: "yesterday", dataType: FieldTypeDate}).AsTime()
+	assert.NotNil(err)
+
+	_, err = (&EntryField{value: []interface{}{"nyan"}, dataType: FieldTypeArray}).AsLinks()
+	assert.NotNil(err)
+
+	_, err = (&EntryField{value: "nyan", dataType: FieldTypeSymbol}).AsLinks()
+	assert.NotNil(err)
+}
@@ -0,0 +1,68 @@
+package contentful
+
+import "sort"
+
+// Locales returns the sorted codes of the locales any field of the entry has
+// a value for. Entries of a single locale return that locale.
+func (entry *Entry) Locales() []string {
+	if entry.locale != "" {
+		if len(entry.Fields) == 0 {
+			return []string{}
+		}
+		return []string{entry.locale}
+	}
+
+	seen := map[string]bool{}
+	for _, value := range entry.Fields {
+		localized, ok := value.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for code := range localized {
+			seen[code] = true
+		}
+	}
+
+	locales := make([]string, 0, len(seen))
+	for code := range seen {
+		locales = append(locales, code)
+	}
+	sort.Strings(locales)
+
+	return locales
+}
+
+// MissingLocales returns, by field id, the given locales the field has no
+// value for, e.g. to flag incomplete translations. Fields translated to all
+// the locales are left out. Entries of a single locale have values of that
+// locale only.
+func (entry *Entry) MissingLocales(allLocales []string) map[string][]string {
+	missing := map[string][]string{}
+
+	for fieldID, value := range entry.Fields {
+		for _, code := range allLocales {
+			if !entry.hasLocalizedValue(value, code) {
+				missing[fieldID] = append(missing[fieldID], code)
+			}
+		}
+	}
+
+	return missing
+}
+
+// hasLocalizedValue reports whether the field's value has a value of the
+// given locale
+func (entry *Entry) hasLocalizedValue(value interface{}, code string) bool {
+	if entry.locale != "" {
+		return entry.locale == code && value != nil
+	}
+
+	localized, ok := value.(map[string]interface{})
+	if !ok {
+		return false
+	}
+
+	val, ok := localized[code]
+	return ok && val != nil
+}
@@ -0,0 +1,61 @@
+package contentful
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func partiallyTranslatedEntry(t *testing.T) *Entry {
+	entry := &Entry{}
+	err := json.Unmarshal([]byte(`{
+		"sys": {"id": "nyancat"},
+		"fields": {
+			"name": {"en-US": "Nyan Cat", "de-DE": "Nyan Katze", "fr-FR": "Nyan Chat"},
+			"color": {"en-US": "rainbow", "de-DE": "regenbogen"},
+			"lifes": {"en-US": 1337},
+			"bestFriend": {"en-US": null, "tr-TR": "Happy Cat"}
+		}
+	}`), entry)
+	assert.Nil(t, err)
+
+	return entry
+}
+
+func TestEntryLocales(t *testing.T) {
+	assert := assert.New(t)
+
+	entry := partiallyTranslatedEntry(t)
+	assert.Equal([]string{"de-DE", "en-US", "fr-FR", "tr-TR"}, entry.Locales())
+
+	assert.Equal([]string{}, (&Entry{}).Locales())
+
+	entry.CollapseLocale("en-US")
+	assert.Equal([]string{"en-US"}, entry.Locales())
+}
+
+func TestEntryMissingLocales(t *testing.T) {
+	assert := assert.New(t)
+
+	entry := partiallyTranslatedEntry(t)
+	assert.Equal(map[string][]string{
+		"color":      {"fr-FR"},
+		"lifes":      {"de-DE", "fr-FR"},
+		"bestFriend": {"en-US", "de-DE", "fr-FR"},
+	}, entry.MissingLocales([]string{"en-US", "de-DE", "fr-FR"}))
+
+	// fully translated fields are left out
+	assert.Equal(map[string][]string{
+		"bestFriend": {"en-US"},
+	}, entry.MissingLocales([]string{"en-US"}))
+	assert.Equal(map[string][]string{}, entry.MissingLocales(nil))
+
+	// entries of a single locale have values of that locale only
+	entry = &Entry{Fields: map[string]interface{}{"name": "Nyan Katze", "color": nil}}
+	entry.SetLocale("de-DE")
+	assert.Equal(map[string][]string{
+		"name":  {"en-US"},
+		"color": {"en-US", "de-DE"},
+	}, entry.MissingLocales([]string{"en-US", "de-DE"}))
+}
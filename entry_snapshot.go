@@ -0,0 +1,75 @@
+package contentful
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Snapshot model, the state of an entry frozen when it was published
+type Snapshot struct {
+	Sys      *Sys   `json:"sys"`
+	Snapshot *Entry `json:"snapshot"`
+}
+
+// ListSnapshots returns the snapshots collection of the entry
+func (service *EntriesService) ListSnapshots(spaceID, entryID string) *Collection {
+	path := service.c.envPath(spaceID, "/entries/%s/snapshots", entryID)
+
+	req, err := service.c.newRequest(http.MethodGet, path, nil, nil)
+	if err != nil {
+		return &Collection{}
+	}
+
+	col := NewCollection(&CollectionOptions{})
+	col.c = service.c
+	col.req = req
+
+	return col
+}
+
+// GetSnapshot returns a single snapshot of the entry
+func (service *EntriesService) GetSnapshot(spaceID, entryID, snapshotID string) (*Snapshot, error) {
+	return service.getSnapshot(context.Background(), spaceID, entryID, snapshotID)
+}
+
+func (service *EntriesService) getSnapshot(ctx context.Context, spaceID, entryID, snapshotID string) (*Snapshot, error) {
+	path := service.c.envPath(spaceID, "/entries/%s/snapshots/%s", entryID, snapshotID)
+
+	req, err := service.c.newRequest(http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var snapshot Snapshot
+	if err := service.c.do(withContext(req, ctx), &snapshot); err != nil {
+		return nil, err
+	}
+
+	return &snapshot, nil
+}
+
+// RestoreSnapshot reverts the entry to the fields of the given snapshot. The
+// fields are updated onto the current entry with its current version, the
+// restored entry is a draft then until it is published. When the content
+// type changed since the snapshot was taken, e.g. a field was removed, the
+// update fails with a ValidationFailedError, see its FieldErrors.
+func (service *EntriesService) RestoreSnapshot(ctx context.Context, spaceID, entryID, snapshotID string) error {
+	snapshot, err := service.getSnapshot(ctx, spaceID, entryID, snapshotID)
+	if err != nil {
+		return err
+	}
+
+	if snapshot.Snapshot == nil {
+		return fmt.Errorf("snapshot %s of entry %s has no entry", snapshotID, entryID)
+	}
+
+	entry, err := service.get(ctx, spaceID, entryID)
+	if err != nil {
+		return err
+	}
+
+	entry.Fields = snapshot.Snapshot.Fields
+
+	return service.update(ctx, spaceID, entry)
+}
@@ -0,0 +1,82 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEntriesServiceRestoreSnapshot(t *testing.T) {
+	assert := assert.New(t)
+
+	base := "/spaces/" + spaceID + "/environments/master/entries/nyancat"
+
+	var restored map[string]interface{}
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET " + base + "/snapshots/snapshot-1":
+			fmt.Fprintln(w, `{
+				"sys": {"id": "snapshot-1", "type": "Snapshot", "snapshotType": "publish"},
+				"snapshot": {
+					"sys": {"id": "nyancat", "type": "Entry"},
+					"fields": {"name": {"en-US": "Nyan Cat"}, "lives": {"en-US": 9}}
+				}
+			}`)
+		case "GET " + base:
+			fmt.Fprintln(w, `{"sys": {"id": "nyancat", "version": 7, "contentType": {"sys": {"id": "cat"}}}, "fields": {"name": {"en-US": "Happy Cat"}, "lives": {"en-US": 1}}}`)
+		case "PUT " + base:
+			assert.Equal("7", r.Header.Get("X-Contentful-Version"))
+			assert.Equal("cat", r.Header.Get("X-Contentful-Content-Type"))
+
+			var payload map[string]interface{}
+			assert.Nil(json.NewDecoder(r.Body).Decode(&payload))
+			restored = payload["fields"].(map[string]interface{})
+
+			fmt.Fprintln(w, `{"sys": {"id": "nyancat", "version": 8}}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	cma = NewCMA(CMAToken, WithBaseURL(server.URL))
+
+	err := cma.Entries.RestoreSnapshot(context.Background(), spaceID, "nyancat", "snapshot-1")
+	assert.Nil(err)
+	assert.Equal(map[string]interface{}{
+		"name":  map[string]interface{}{"en-US": "Nyan Cat"},
+		"lives": map[string]interface{}{"en-US": float64(9)},
+	}, restored)
+}
+
+func TestEntriesServiceRestoreSnapshotValidationFailed(t *testing.T) {
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PUT" {
+			w.WriteHeader(422)
+			fmt.Fprintln(w, readTestData("error-validationfailed.json"))
+			return
+		}
+
+		fmt.Fprintln(w, `{"sys": {"id": "nyancat", "version": 7}, "snapshot": {"fields": {"name": {"en-US": "Nyan Cat"}}}}`)
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	cma = NewCMA(CMAToken, WithBaseURL(server.URL))
+
+	// the content type changed since the snapshot was taken
+	err := cma.Entries.RestoreSnapshot(context.Background(), spaceID, "nyancat", "snapshot-1")
+	validationErr, ok := err.(ValidationFailedError)
+	assert.True(ok)
+	assert.Equal("The property \"title\" is required here", validationErr.FieldErrors()["fields.title"])
+}
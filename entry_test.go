@@ -1,11 +1,16 @@
 package contentful
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -36,7 +41,7 @@ func ExampleEntriesService_Upsert_create() {
 	}
 }
 
-func ExampleEntryService_Upsert_update() {
+func ExampleEntriesService_Upsert_update() {
 	cma := NewCMA("cma-token")
 
 	entry, err := cma.Entries.Get("space-id", "entry-id")
@@ -103,4 +108,1121 @@ func TestEntrySaveForCreate(t *testing.T) {
 	err = cma.Entries.Upsert("id1", entry)
 	assert.Nil(err)
 	assert.Equal("foocat", entry.Sys.ID)
-}
\ No newline at end of file
+}
+
+func TestEntryMarshalJSON(t *testing.T) {
+	var err error
+	assert := assert.New(t)
+
+	entry := &Entry{
+		Sys: &Sys{
+			ID:      "foocat",
+			Version: 3,
+		},
+		Fields: map[string]interface{}{
+			"name": map[string]interface{}{
+				"en-US": "foo cat",
+			},
+		},
+	}
+
+	data, err := json.Marshal(entry)
+	assert.Nil(err)
+	assert.JSONEq(`{"sys":{"id":"foocat","version":3},"fields":{"name":{"en-US":"foo cat"}}}`, string(data))
+
+	var decoded Entry
+	err = json.Unmarshal(data, &decoded)
+	assert.Nil(err)
+	assert.Equal(entry.Sys, decoded.Sys)
+	assert.Equal(entry.Fields, decoded.Fields)
+
+	// entries without sys are marshaled with fields only
+	data, err = json.Marshal(&Entry{Fields: map[string]interface{}{}})
+	assert.Nil(err)
+	assert.JSONEq(`{"fields":{}}`, string(data))
+}
+
+func TestEntryLocale(t *testing.T) {
+	var err error
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/master/entries/foocat")
+		checkHeaders(r, assert)
+
+		// the stored entry is fetched to keep the values of other locales
+		if r.Method == "GET" {
+			fmt.Fprintln(w, string(readTestData("entry_3.json")))
+			return
+		}
+
+		assert.Equal(r.Method, "PUT")
+
+		var payload map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assert.Nil(err)
+
+		fields := payload["fields"].(map[string]interface{})
+		assert.Equal(map[string]interface{}{"en-US": "Some test content..."}, fields["Description"])
+		assert.Equal(map[string]interface{}{"en-US": "Nyan Cat", "tlh": "Nyan vIghro'"}, fields["name"])
+
+		w.WriteHeader(200)
+		fmt.Fprintln(w, string(readTestData("entry_3.json")))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	entry, err := entryFromTestData("entry_3.json")
+	assert.Nil(err)
+	assert.Equal("", entry.Locale())
+	assert.Equal(map[string]interface{}{"en-US": "Nyan Cat", "tlh": "Nyan vIghro'"}, entry.Fields["name"])
+
+	entry.SetLocale("en-US")
+	assert.Equal("en-US", entry.Locale())
+	entry.Fields = map[string]interface{}{
+		"Description": "Some test content...",
+	}
+
+	err = cma.Entries.Upsert(spaceID, entry)
+	assert.Nil(err)
+
+	// the response is flattened to the entry's locale
+	assert.Equal("en-US", entry.Locale())
+	assert.Equal("Nyan Cat", entry.Fields["name"])
+}
+
+func TestEntryLocaleMarshal(t *testing.T) {
+	assert := assert.New(t)
+
+	entry := &Entry{locale: "de-DE"}
+	assert.Nil(json.Unmarshal([]byte(`{"fields": {
+		"name": {"en-US": "Nyan Cat", "de-DE": "Nyan Katze"},
+		"color": {"en-US": "rainbow"}
+	}}`), entry))
+	assert.Equal("Nyan Katze", entry.Fields["name"])
+	assert.Equal(map[string]interface{}{"en-US": "rainbow"}, entry.Fields["color"])
+
+	// other locales are kept, fields without a value of the locale are not
+	// wrapped again
+	byteArray, err := json.Marshal(entry)
+	assert.Nil(err)
+	assert.JSONEq(`{"fields": {
+		"name": {"en-US": "Nyan Cat", "de-DE": "Nyan Katze"},
+		"color": {"en-US": "rainbow"}
+	}}`, string(byteArray))
+
+	assert.Nil(entry.setLocalizedValue("color", "de-DE", "Regenbogen"))
+	byteArray, err = json.Marshal(entry)
+	assert.Nil(err)
+	assert.JSONEq(`{"fields": {
+		"name": {"en-US": "Nyan Cat", "de-DE": "Nyan Katze"},
+		"color": {"en-US": "rainbow", "de-DE": "Regenbogen"}
+	}}`, string(byteArray))
+}
+
+func TestEntryLocaleCollection(t *testing.T) {
+	var err error
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(r.Method, "GET")
+		assert.Equal("en-US", r.URL.Query().Get("locale"))
+
+		w.WriteHeader(200)
+		fmt.Fprintln(w, string(readTestData("spaces-id1-entries.json")))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	col := cma.Entries.List(spaceID)
+	col.Locale("en-US")
+
+	_, err = col.Next()
+	assert.Nil(err)
+
+	entries := col.ToEntry()
+	assert.True(len(entries) > 0)
+
+	for _, entry := range entries {
+		assert.Equal("en-US", entry.Locale())
+	}
+}
+
+func TestEntriesServiceCreate(t *testing.T) {
+	var err error
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(r.Method, "POST")
+		assert.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/master/entries")
+		assert.Equal("cat", r.Header.Get("X-Contentful-Content-Type"))
+		assert.Equal("", r.Header.Get("X-Contentful-Version"))
+		checkHeaders(r, assert)
+
+		w.WriteHeader(201)
+		fmt.Fprintln(w, string(readTestData("entry_3.json")))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	entry := &Entry{
+		Fields: map[string]interface{}{
+			"name": map[string]string{
+				"en-US": "Nyan Cat",
+			},
+		},
+	}
+
+	err = cma.Entries.Create(context.Background(), spaceID, "cat", entry)
+	assert.Nil(err)
+	assert.Equal("foocat", entry.Sys.ID)
+}
+
+func TestEntriesServiceCreateWithoutContentTypeLink(t *testing.T) {
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("POST", r.Method)
+		assert.Equal("/spaces/"+spaceID+"/environments/master/entries", r.URL.Path)
+		assert.Equal("cat", r.Header.Get("X-Contentful-Content-Type"))
+		checkHeaders(r, assert)
+
+		w.WriteHeader(201)
+		fmt.Fprintln(w, string(readTestData("entry_3.json")))
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	cma = NewCMA(CMAToken, WithBaseURL(server.URL))
+
+	entry := &Entry{
+		Fields: map[string]interface{}{
+			"name": map[string]string{"en-US": "Nyan Cat"},
+		},
+	}
+
+	err := cma.Entries.Create(context.Background(), spaceID, "cat", entry)
+	assert.Nil(err)
+	assert.Equal("foocat", entry.Sys.ID)
+
+	// the content type id is required
+	err = cma.Entries.Create(context.Background(), spaceID, "", &Entry{})
+	assert.NotNil(err)
+
+	// upserts still need the content type link
+	err = cma.Entries.Upsert(spaceID, &Entry{Sys: &Sys{CreatedAt: "2018-01-01T00:00:00Z", ID: "foocat"}})
+	assert.NotNil(err)
+}
+
+func TestEntriesServiceUpdate(t *testing.T) {
+	var err error
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(r.Method, "PUT")
+		assert.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/master/entries/foocat")
+		assert.Equal("4", r.Header.Get("X-Contentful-Version"))
+		checkHeaders(r, assert)
+
+		w.WriteHeader(200)
+		fmt.Fprintln(w, string(readTestData("entry_3.json")))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	// an entry with a known id but no createdAt is still updated
+	entry := &Entry{
+		Sys: &Sys{
+			ID:      "foocat",
+			Version: 4,
+		},
+		Fields: map[string]interface{}{},
+	}
+
+	err = cma.Entries.Update(context.Background(), spaceID, entry)
+	assert.Nil(err)
+
+	err = cma.Entries.Update(context.Background(), spaceID, &Entry{})
+	assert.NotNil(err)
+}
+
+func TestEntryCreateWithID(t *testing.T) {
+	var err error
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(r.Method, "PUT")
+		assert.Equal(r.RequestURI, "/spaces/"+spaceID+"/environments/master/entries/foocat")
+		assert.Equal("cat", r.Header.Get("X-Contentful-Content-Type"))
+		_, ok := r.Header["X-Contentful-Version"]
+		assert.False(ok)
+		checkHeaders(r, assert)
+
+		w.WriteHeader(201)
+		fmt.Fprintln(w, string(readTestData("entry_3.json")))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	entry := &Entry{
+		Sys: &Sys{
+			ID: "foocat",
+			ContentType: &ContentType{
+				Sys: &Sys{
+					ID: "cat",
+				},
+			},
+		},
+		Fields: map[string]interface{}{
+			"name": map[string]string{
+				"en-US": "Nyan Cat",
+			},
+		},
+	}
+
+	err = cma.Entries.Upsert(spaceID, entry)
+	assert.Nil(err)
+	assert.Equal("2013-06-27T22:46:19.513Z", entry.Sys.CreatedAt)
+}
+
+func TestEntryUpsertWithoutSys(t *testing.T) {
+	var err error
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Fail("no request expected")
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	entry := &Entry{
+		Fields: map[string]interface{}{
+			"name": map[string]string{
+				"en-US": "Nyan Cat",
+			},
+		},
+	}
+
+	err = cma.Entries.Upsert(spaceID, entry)
+	assert.EqualError(err, "entry.Sys must be set with a ContentType before upsert")
+
+	entry.Sys = &Sys{ID: "foocat"}
+	err = cma.Entries.Upsert(spaceID, entry)
+	assert.EqualError(err, "entry.Sys must be set with a ContentType before upsert")
+}
+
+func TestEntryExpandAndCollapseLocale(t *testing.T) {
+	assert := assert.New(t)
+
+	entry := &Entry{
+		Fields: map[string]interface{}{
+			"title":    "Nyan Cat",
+			"name":     map[string]interface{}{"en-US": "Nyan Cat", "tlh": "Nyan vIghro'"},
+			"location": map[string]interface{}{"lat": 1.5, "lon": 2.5},
+			"lives":    float64(9),
+		},
+	}
+
+	entry.ExpandToLocale("en-US")
+	expanded := map[string]interface{}{
+		"title":    map[string]interface{}{"en-US": "Nyan Cat"},
+		"name":     map[string]interface{}{"en-US": "Nyan Cat", "tlh": "Nyan vIghro'"},
+		"location": map[string]interface{}{"en-US": map[string]interface{}{"lat": 1.5, "lon": 2.5}},
+		"lives":    map[string]interface{}{"en-US": float64(9)},
+	}
+	assert.Equal(expanded, entry.Fields)
+	assert.Equal("", entry.Locale())
+
+	// expanding is idempotent
+	entry.ExpandToLocale("en-US")
+	assert.Equal(expanded, entry.Fields)
+
+	entry.CollapseLocale("en-US")
+	assert.Equal(map[string]interface{}{
+		"title":    "Nyan Cat",
+		"name":     "Nyan Cat",
+		"location": map[string]interface{}{"lat": 1.5, "lon": 2.5},
+		"lives":    float64(9),
+	}, entry.Fields)
+	assert.Equal("en-US", entry.Locale())
+
+	// collapsed entries are sent as locale maps
+	byteArray, err := json.Marshal(entry)
+	assert.Nil(err)
+	assert.JSONEq(`{"fields": {
+		"title": {"en-US": "Nyan Cat"},
+		"name": {"en-US": "Nyan Cat", "tlh": "Nyan vIghro'"},
+		"location": {"en-US": {"lat": 1.5, "lon": 2.5}},
+		"lives": {"en-US": 9}
+	}}`, string(byteArray))
+
+	// collapsing to a locale the value has not got leaves the value
+	mixed := &Entry{
+		Fields: map[string]interface{}{
+			"name":  map[string]interface{}{"tlh": "Nyan vIghro'"},
+			"title": "Nyan Cat",
+		},
+	}
+	mixed.CollapseLocale("en-US")
+	assert.Equal(map[string]interface{}{"tlh": "Nyan vIghro'"}, mixed.Fields["name"])
+	assert.Equal("Nyan Cat", mixed.Fields["title"])
+
+	byteArray, err = json.Marshal(mixed)
+	assert.Nil(err)
+	assert.JSONEq(`{"fields": {
+		"name": {"tlh": "Nyan vIghro'"},
+		"title": {"en-US": "Nyan Cat"}
+	}}`, string(byteArray))
+
+	var decoded Entry
+	assert.Nil(json.Unmarshal(byteArray, &decoded))
+	decoded.CollapseLocale("en-US")
+	assert.Equal(mixed.Fields, decoded.Fields)
+
+	// expanding a collapsed entry brings back the other locales
+	entry.ExpandToLocale("en-US")
+	assert.Equal(expanded, entry.Fields)
+	assert.Equal("", entry.Locale())
+}
+
+func TestEntriesServicePublishAll(t *testing.T) {
+	assert := assert.New(t)
+
+	var mu sync.Mutex
+	requests := map[string]string{}
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("PUT", r.Method)
+		checkHeaders(r, assert)
+
+		id := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/spaces/"+spaceID+"/environments/master/entries/"), "/published")
+
+		mu.Lock()
+		requests[id] = r.Header.Get("X-Contentful-Version")
+		mu.Unlock()
+
+		if id == "entry-3" {
+			w.WriteHeader(409)
+			fmt.Fprintln(w, `{"sys": {"type": "Error", "id": "VersionMismatch"}}`)
+			return
+		}
+
+		version, _ := strconv.Atoi(r.Header.Get("X-Contentful-Version"))
+		w.WriteHeader(200)
+		fmt.Fprintf(w, `{"sys": {"id": "%s", "version": %d, "publishedVersion": %d}, "fields": {}}`, id, version+1, version)
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	entries := []*Entry{}
+	for i := 1; i <= 10; i++ {
+		entries = append(entries, &Entry{
+			Sys:    &Sys{ID: fmt.Sprintf("entry-%d", i), Version: i},
+			Fields: map[string]interface{}{"title": "title"},
+		})
+	}
+
+	published, failed := cma.Entries.PublishAll(context.Background(), spaceID, entries, 3)
+	assert.Equal(9, len(published))
+	assert.Equal(1, len(failed))
+	assert.IsType(VersionMismatchError{}, failed["entry-3"])
+	assert.Equal(10, len(requests))
+
+	for _, entry := range published {
+		assert.NotEqual("entry-3", entry.Sys.ID)
+		assert.Equal(entry.Sys.Version-1, entry.Sys.PublishedVersion)
+		assert.Equal(strconv.Itoa(entry.Sys.PublishedVersion), requests[entry.Sys.ID])
+		assert.Equal("title", entry.Fields["title"])
+	}
+	assert.Equal(3, entries[2].Sys.Version)
+
+	// nothing is published once the context is done
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	published, failed = cma.Entries.PublishAll(ctx, spaceID, entries, 3)
+	assert.Equal(0, len(published))
+	assert.Equal(10, len(failed))
+	for _, err := range failed {
+		assert.NotNil(err)
+	}
+}
+
+func TestEntriesServiceCreateIdempotent(t *testing.T) {
+	assert := assert.New(t)
+
+	id := IdempotentID("import-42")
+	created := false
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		checkHeaders(r, assert)
+
+		switch r.Method {
+		case "PUT":
+			assert.Equal("/spaces/"+spaceID+"/environments/master/entries/"+id, r.URL.Path)
+			assert.Equal("cat", r.Header.Get("X-Contentful-Content-Type"))
+
+			if created {
+				w.WriteHeader(409)
+				fmt.Fprintln(w, `{"sys": {"type": "Error", "id": "VersionMismatch"}}`)
+				return
+			}
+
+			created = true
+			w.WriteHeader(201)
+		case "GET":
+			assert.Equal("/spaces/"+spaceID+"/environments/master/entries/"+id, r.URL.Path)
+			w.WriteHeader(200)
+		}
+
+		fmt.Fprintf(w, `{"sys": {"id": "%s", "version": 1, "createdAt": "2019-07-01T10:00:00Z"}, "fields": {"name": {"en-US": "Nyan Cat"}}}`, id)
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	entry := &Entry{Fields: map[string]interface{}{"name": map[string]interface{}{"en-US": "Nyan Cat"}}}
+	assert.Nil(cma.Entries.CreateIdempotent(context.Background(), spaceID, "cat", "import-42", entry))
+	assert.Equal(id, entry.Sys.ID)
+
+	// a replayed create returns the existing entry
+	replayed := &Entry{Fields: map[string]interface{}{"name": map[string]interface{}{"en-US": "Nyan Cat"}}}
+	assert.Nil(cma.Entries.CreateIdempotent(context.Background(), spaceID, "cat", "import-42", replayed))
+	assert.Equal(id, replayed.Sys.ID)
+	assert.Equal("2019-07-01T10:00:00Z", replayed.Sys.CreatedAt)
+	assert.Equal(map[string]interface{}{"en-US": "Nyan Cat"}, replayed.Fields["name"])
+}
+
+func TestEntrySetLinks(t *testing.T) {
+	assert := assert.New(t)
+
+	entry := &Entry{
+		Fields: map[string]interface{}{
+			"bestFriend": map[string]interface{}{"tlh": NewEntryLink("nyancat")},
+		},
+	}
+
+	assert.Nil(entry.SetLink("bestFriend", "en-US", "happycat", "Entry"))
+	assert.Nil(entry.SetLinks("images", "en-US", []string{"nyancat", "happycat"}, "Asset"))
+	assert.NotNil(entry.SetLink("bestFriend", "en-US", "happycat", "ContentType"))
+	assert.NotNil(entry.SetLinks("images", "en-US", []string{"nyancat"}, "entry"))
+
+	byteArray, err := json.Marshal(entry)
+	assert.Nil(err)
+	assert.JSONEq(`{"fields": {
+		"bestFriend": {
+			"tlh": {"sys": {"type": "Link", "linkType": "Entry", "id": "nyancat"}},
+			"en-US": {"sys": {"type": "Link", "linkType": "Entry", "id": "happycat"}}
+		},
+		"images": {"en-US": [
+			{"sys": {"type": "Link", "linkType": "Asset", "id": "nyancat"}},
+			{"sys": {"type": "Link", "linkType": "Asset", "id": "happycat"}}
+		]}
+	}}`, string(byteArray))
+
+	// entries of a single locale hold the links directly
+	single := &Entry{}
+	single.SetLocale("en-US")
+	assert.Nil(single.SetLink("bestFriend", "en-US", "happycat", "Entry"))
+	assert.Equal(NewEntryLink("happycat"), single.Fields["bestFriend"])
+	assert.NotNil(single.SetLink("bestFriend", "tlh", "happycat", "Entry"))
+
+	byteArray, err = json.Marshal(single)
+	assert.Nil(err)
+	assert.JSONEq(`{"fields": {"bestFriend": {"en-US": {"sys": {"type": "Link", "linkType": "Entry", "id": "happycat"}}}}}`, string(byteArray))
+}
+
+func TestEntryLocation(t *testing.T) {
+	assert := assert.New(t)
+
+	entry := &Entry{}
+	location := &Location{Latitude: 51.5074, Longitude: -0.1278}
+	assert.Nil(entry.SetLocation("home", "en-US", location))
+
+	byteArray, err := json.Marshal(entry)
+	assert.Nil(err)
+	assert.JSONEq(`{"fields": {"home": {"en-US": {"lat": 51.5074, "lon": -0.1278}}}}`, string(byteArray))
+
+	// round trip
+	var decoded Entry
+	assert.Nil(json.Unmarshal(byteArray, &decoded))
+
+	got, err := decoded.GetLocation("home", "en-US")
+	assert.Nil(err)
+	assert.Equal(location, got)
+
+	_, err = decoded.GetLocation("home", "tlh")
+	assert.NotNil(err)
+	_, err = decoded.GetLocation("work", "en-US")
+	assert.NotNil(err)
+
+	decoded.Fields["name"] = map[string]interface{}{"en-US": "Nyan Cat"}
+	_, err = decoded.GetLocation("name", "en-US")
+	assert.NotNil(err)
+
+	// entries of a single locale hold the location directly
+	decoded.CollapseLocale("en-US")
+	got, err = decoded.GetLocation("home", "en-US")
+	assert.Nil(err)
+	assert.Equal(location, got)
+}
+
+func TestEntriesServiceGetEntryKeyCache(t *testing.T) {
+	assert := assert.New(t)
+
+	var fetched int32
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("GET", r.Method)
+		assert.Equal("/spaces/"+spaceID+"/environments/master/content_types/cat", r.URL.Path)
+		atomic.AddInt32(&fetched, 1)
+
+		byteArray, err := json.Marshal(catContentType(t))
+		assert.Nil(err)
+		w.Write(byteArray)
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	entry := &Entry{
+		Sys: &Sys{
+			Space:       &Space{Sys: &Sys{ID: spaceID}},
+			ContentType: &ContentType{Sys: &Sys{ID: "cat"}},
+		},
+		Fields: map[string]interface{}{
+			"name":  "Nyan Cat",
+			"lifes": float64(1337),
+		},
+	}
+
+	name, err := cma.Entries.GetEntryKey(entry, "name")
+	assert.Nil(err)
+	assert.Equal(FieldTypeText, name.dataType)
+
+	lifes, err := cma.Entries.GetEntryKey(entry, "lifes")
+	assert.Nil(err)
+	assert.Equal(FieldTypeInteger, lifes.dataType)
+	assert.Equal(int32(1), atomic.LoadInt32(&fetched))
+
+	cma.Entries.InvalidateContentTypeCache()
+
+	_, err = cma.Entries.GetEntryKey(entry, "name")
+	assert.Nil(err)
+	assert.Equal(int32(2), atomic.LoadInt32(&fetched))
+}
+
+func TestEntriesServiceGetEntryKeyManyContentTypes(t *testing.T) {
+	assert := assert.New(t)
+
+	// the space has more content types than fit a page, the entry's content
+	// type is not on the first one
+	page := &Collection{Total: 150, Limit: 100}
+	for i := 0; i < 100; i++ {
+		page.Items = append(page.Items, &ContentType{
+			Sys:  &Sys{ID: fmt.Sprintf("type%d", i), Type: "ContentType"},
+			Name: fmt.Sprintf("Type %d", i),
+		})
+	}
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var v interface{}
+
+		switch r.URL.Path {
+		case "/spaces/" + spaceID + "/environments/master/content_types":
+			v = page
+		case "/spaces/" + spaceID + "/environments/master/content_types/cat":
+			v = catContentType(t)
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+
+		byteArray, err := json.Marshal(v)
+		assert.Nil(err)
+		w.Write(byteArray)
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	entry := &Entry{
+		Sys: &Sys{
+			Space:       &Space{Sys: &Sys{ID: spaceID}},
+			ContentType: &ContentType{Sys: &Sys{ID: "cat"}},
+		},
+		Fields: map[string]interface{}{
+			"birthday": "1979-06-18T23:00:00.000Z",
+		},
+	}
+
+	birthday, err := cma.Entries.GetEntryKey(entry, "birthday")
+	assert.Nil(err)
+	assert.Equal(FieldTypeDate, birthday.dataType)
+}
+
+// catContentType returns the cat content type of the content types test data
+func catContentType(t *testing.T) *ContentType {
+	col, err := collectionFromTestData("content_types.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, ct := range col.ToContentType() {
+		if ct.Sys.ID == "cat" {
+			return ct
+		}
+	}
+
+	t.Fatal("no cat content type in test data")
+
+	return nil
+}
+
+func TestEntriesServiceGetEntryKeyEnvironment(t *testing.T) {
+	assert := assert.New(t)
+
+	paths := []string{}
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.Method+" "+r.URL.Path)
+
+		byteArray, err := json.Marshal(catContentType(t))
+		assert.Nil(err)
+		w.Write(byteArray)
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	entry := &Entry{
+		Sys: &Sys{
+			Space:       &Space{Sys: &Sys{ID: spaceID}},
+			ContentType: &ContentType{Sys: &Sys{ID: "cat"}},
+		},
+		Fields: map[string]interface{}{
+			"color": "rainbow",
+		},
+	}
+
+	color, err := cma.WithEnvironment("staging").Entries.GetEntryKey(entry, "color")
+	assert.Nil(err)
+	assert.Equal(FieldTypeSymbol, color.dataType)
+
+	assert.Equal([]string{
+		"GET /spaces/" + spaceID + "/environments/staging/content_types/cat",
+	}, paths)
+}
+
+func TestEntriesServiceGetIfModified(t *testing.T) {
+	assert := assert.New(t)
+
+	requests := 0
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Equal("GET", r.Method)
+		assert.Equal("/spaces/"+spaceID+"/environments/master/entries/nyancat", r.URL.Path)
+
+		w.Header().Set("ETag", `"v1"`)
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+
+		fmt.Fprintln(w, readTestData("spaces-id1-entries-nyancat.json"))
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	cached, etag, notModified, err := cma.Entries.GetIfModified(context.Background(), spaceID, "nyancat", "")
+	assert.Nil(err)
+	assert.False(notModified)
+	assert.Equal(`"v1"`, etag)
+	assert.Equal("nyancat", cached.Sys.ID)
+
+	entry, etag, notModified, err := cma.Entries.GetIfModified(context.Background(), spaceID, "nyancat", etag)
+	assert.Nil(err)
+	assert.True(notModified)
+	assert.Nil(entry)
+	assert.Equal(`"v1"`, etag)
+	assert.Equal(2, requests)
+
+	// the cached entry is kept when the entry is not modified
+	if notModified {
+		entry = cached
+	}
+	assert.Equal("nyancat", entry.Sys.ID)
+}
+
+func TestEntriesServiceGetLocalized(t *testing.T) {
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("GET", r.Method)
+		assert.Equal("/spaces/"+spaceID+"/environments/master/entries/nyancat", r.URL.Path)
+
+		if r.URL.Query().Get("locale") == "*" {
+			fmt.Fprintln(w, `{"sys": {"id": "nyancat"}, "fields": {"name": {"en-US": "Nyan Cat", "tlh": "Nyan vIghro'"}}}`)
+			return
+		}
+
+		assert.Equal("tlh", r.URL.Query().Get("locale"))
+		fmt.Fprintln(w, `{"sys": {"id": "nyancat", "locale": "tlh"}, "fields": {"name": "Nyan vIghro'"}}`)
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cda client
+	cda := NewCDA(CDAToken)
+	cda.BaseURL = server.URL
+
+	entry, err := cda.Entries.GetLocalized(context.Background(), spaceID, "nyancat", "tlh")
+	assert.Nil(err)
+	assert.Equal("tlh", entry.Locale())
+	assert.Equal("Nyan vIghro'", entry.Fields["name"])
+
+	entry, err = cda.Entries.GetLocalized(context.Background(), spaceID, "nyancat", "*")
+	assert.Nil(err)
+	assert.Equal("", entry.Locale())
+	assert.Equal(map[string]interface{}{"en-US": "Nyan Cat", "tlh": "Nyan vIghro'"}, entry.Fields["name"])
+}
+
+func TestEntriesServiceUpdateLocalized(t *testing.T) {
+	assert := assert.New(t)
+
+	var payload map[string]interface{}
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("/spaces/"+spaceID+"/environments/master/entries/nyancat", r.URL.Path)
+
+		if r.Method == "GET" && r.URL.Query().Get("locale") == "tlh" {
+			fmt.Fprintln(w, `{"sys": {"id": "nyancat", "version": 3, "locale": "tlh"}, "fields": {
+				"name": "Nyan vIghro'",
+				"bestFriend": {"sys": {"type": "Link", "linkType": "Entry", "id": "happycat"}}
+			}}`)
+			return
+		}
+
+		if r.Method == "GET" {
+			fmt.Fprintln(w, `{"sys": {"id": "nyancat", "version": 3}, "fields": {
+				"name": {"en-US": "Nyan Cat", "tlh": "Nyan vIghro'"},
+				"color": {"en-US": "rainbow"},
+				"bestFriend": {"tlh": {"sys": {"type": "Link", "linkType": "Entry", "id": "happycat"}}}
+			}}`)
+			return
+		}
+
+		assert.Equal("PUT", r.Method)
+		assert.Equal("3", r.Header.Get("X-Contentful-Version"))
+		assert.Nil(json.NewDecoder(r.Body).Decode(&payload))
+
+		fmt.Fprintln(w, `{"sys": {"id": "nyancat", "version": 4}, "fields": {}}`)
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	entry, err := cma.Entries.GetLocalized(context.Background(), spaceID, "nyancat", "tlh")
+	assert.Nil(err)
+
+	entry.Fields["name"] = "Nyan vIghro' nIv"
+	assert.Nil(cma.Entries.Update(context.Background(), spaceID, entry))
+
+	// the values of the other locales survive the update
+	assert.Equal(map[string]interface{}{
+		"name":       map[string]interface{}{"en-US": "Nyan Cat", "tlh": "Nyan vIghro' nIv"},
+		"color":      map[string]interface{}{"en-US": "rainbow"},
+		"bestFriend": map[string]interface{}{"tlh": map[string]interface{}{"sys": map[string]interface{}{"type": "Link", "linkType": "Entry", "id": "happycat"}}},
+	}, payload["fields"])
+	assert.Equal(4, entry.Sys.Version)
+}
+
+func TestEntriesServiceForceDelete(t *testing.T) {
+	assert := assert.New(t)
+
+	requests := []string{}
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path+" "+r.Header.Get("X-Contentful-Version"))
+
+		if strings.HasSuffix(r.URL.Path, "/published") {
+			fmt.Fprintln(w, `{"sys": {"id": "nyancat", "version": 5}}`)
+			return
+		}
+
+		w.WriteHeader(204)
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	published := &Entry{Sys: &Sys{ID: "nyancat", Version: 4, PublishedVersion: 3}}
+	assert.Nil(cma.Entries.ForceDelete(context.Background(), spaceID, published))
+
+	draft := &Entry{Sys: &Sys{ID: "happycat", Version: 2}}
+	assert.Nil(cma.Entries.ForceDelete(context.Background(), spaceID, draft))
+
+	assert.Equal([]string{
+		"DELETE /spaces/" + spaceID + "/environments/master/entries/nyancat/published 4",
+		"DELETE /spaces/" + spaceID + "/environments/master/entries/nyancat 5",
+		"DELETE /spaces/" + spaceID + "/environments/master/entries/happycat 2",
+	}, requests)
+}
+
+func TestEntriesServicePublish(t *testing.T) {
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("PUT", r.Method)
+		assert.Equal("/spaces/"+spaceID+"/environments/master/entries/nyancat/published", r.URL.Path)
+		assert.Equal("3", r.Header.Get("X-Contentful-Version"))
+		checkHeaders(r, assert)
+
+		w.WriteHeader(200)
+		fmt.Fprintln(w, `{"sys": {"id": "nyancat", "version": 4, "publishedVersion": 3}}`)
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	entry := &Entry{Sys: &Sys{ID: "nyancat", Version: 3}}
+	assert.Nil(cma.Entries.Publish(spaceID, entry))
+	assert.Equal(4, entry.Sys.Version)
+	assert.Equal(3, entry.Sys.PublishedVersion)
+}
+
+func TestEntriesServiceDeleteEntry(t *testing.T) {
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("DELETE", r.Method)
+		assert.Equal("/spaces/"+spaceID+"/environments/master/entries/nyancat", r.URL.Path)
+		checkHeaders(r, assert)
+
+		if r.Header.Get("X-Contentful-Version") != "3" {
+			w.WriteHeader(409)
+			fmt.Fprintln(w, `{"sys": {"type": "Error", "id": "VersionMismatch"}}`)
+			return
+		}
+
+		w.WriteHeader(204)
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	err := cma.Entries.DeleteEntry(context.Background(), spaceID, &Entry{Sys: &Sys{ID: "nyancat", Version: 2}})
+	assert.IsType(VersionMismatchError{}, err)
+
+	assert.Nil(cma.Entries.DeleteEntry(context.Background(), spaceID, &Entry{Sys: &Sys{ID: "nyancat", Version: 3}}))
+	assert.NotNil(cma.Entries.DeleteEntry(context.Background(), spaceID, &Entry{}))
+}
+
+func TestEntriesServiceDeleteVersion(t *testing.T) {
+	assert := assert.New(t)
+
+	versions := []string{}
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("DELETE", r.Method)
+		assert.Equal("/spaces/"+spaceID+"/environments/master/entries/nyancat", r.URL.Path)
+		versions = append(versions, r.Header.Get("X-Contentful-Version"))
+
+		w.WriteHeader(204)
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	assert.Nil(cma.Entries.Delete(spaceID, "nyancat"))
+	assert.Nil(cma.Entries.Delete(spaceID, "nyancat", 7))
+	assert.Equal([]string{"", "7"}, versions)
+}
+
+func TestEntriesServiceUpdateField(t *testing.T) {
+	assert := assert.New(t)
+
+	version := 3
+	conflicts := 0
+	updates := []map[string]interface{}{}
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("/spaces/"+spaceID+"/environments/master/entries/nyancat", r.URL.Path)
+
+		if r.Method == "GET" {
+			fmt.Fprintf(w, `{"sys": {"id": "nyancat", "version": %d, "createdAt": "2018-01-01T00:00:00Z", "contentType": {"sys": {"id": "cat"}}}, "fields": {"name": {"en-US": "Nyan Cat", "de-DE": "Nyan Katze"}, "lives": {"en-US": 9}}}`, version)
+			return
+		}
+
+		assert.Equal("PUT", r.Method)
+
+		// another process updates the entry before the first update
+		if conflicts > 0 {
+			conflicts--
+			version++
+			w.WriteHeader(409)
+			fmt.Fprintln(w, `{"sys": {"type": "Error", "id": "VersionMismatch"}}`)
+			return
+		}
+
+		assert.Equal(strconv.Itoa(version), r.Header.Get("X-Contentful-Version"))
+
+		var payload map[string]interface{}
+		assert.Nil(json.NewDecoder(r.Body).Decode(&payload))
+		updates = append(updates, payload["fields"].(map[string]interface{}))
+
+		version++
+		fmt.Fprintf(w, `{"sys": {"id": "nyancat", "version": %d}}`, version)
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	cma = NewCMA(CMAToken, WithBaseURL(server.URL))
+
+	err := cma.Entries.UpdateField(context.Background(), spaceID, "nyancat", "name", "en-US", "Happy Cat")
+	assert.Nil(err)
+	assert.Equal([]map[string]interface{}{{
+		"name":  map[string]interface{}{"en-US": "Happy Cat", "de-DE": "Nyan Katze"},
+		"lives": map[string]interface{}{"en-US": float64(9)},
+	}}, updates)
+
+	// the update is retried once after a conflict
+	conflicts = 1
+	updates = nil
+	err = cma.Entries.UpdateField(context.Background(), spaceID, "nyancat", "lives", "en-US", 8)
+	assert.Nil(err)
+	assert.Equal(1, len(updates))
+	assert.Equal(map[string]interface{}{"en-US": float64(8)}, updates[0]["lives"])
+
+	// but not twice
+	conflicts = 2
+	err = cma.Entries.UpdateField(context.Background(), spaceID, "nyancat", "lives", "en-US", 7)
+	_, ok := err.(VersionMismatchError)
+	assert.True(ok)
+}
+
+func TestEntriesServiceUseNumber(t *testing.T) {
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/entries") {
+			fmt.Fprintln(w, `{"total": 1, "items": [{"sys": {"id": "nyancat"}, "fields": {"lifes": {"en-US": 9007199254740993}}}]}`)
+			return
+		}
+		fmt.Fprintln(w, `{"sys": {"id": "nyancat"}, "fields": {"lifes": {"en-US": 9007199254740993}, "weight": {"en-US": 4.2}}}`)
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// numbers are float64 by default
+	cma = NewCMA(CMAToken, WithBaseURL(server.URL))
+	entry, err := cma.Entries.Get(spaceID, "nyancat")
+	assert.Nil(err)
+	assert.Equal(map[string]interface{}{"en-US": float64(9007199254740992)}, entry.Fields["lifes"])
+
+	cma = NewCMA(CMAToken, WithBaseURL(server.URL), WithUseNumber())
+	entry, err = cma.Entries.Get(spaceID, "nyancat")
+	assert.Nil(err)
+	assert.Equal(map[string]interface{}{"en-US": json.Number("9007199254740993")}, entry.Fields["lifes"])
+	assert.Equal(map[string]interface{}{"en-US": json.Number("4.2")}, entry.Fields["weight"])
+
+	lifes := &EntryField{value: entry.Fields["lifes"], dataType: FieldTypeInteger}
+	assert.Equal(9007199254740993, lifes.LInteger("en-US"))
+
+	col, err := cma.Entries.List(spaceID).Next()
+	assert.Nil(err)
+	entries := col.ToEntry()
+	assert.Equal(1, len(entries))
+	assert.Equal(map[string]interface{}{"en-US": json.Number("9007199254740993")}, entries[0].Fields["lifes"])
+
+	col, err = cma.Entries.List(spaceID).Next()
+	assert.Nil(err)
+	entries, err = CollectionOf[*Entry](col)
+	assert.Nil(err)
+	assert.Equal(1, len(entries))
+	assert.Equal(map[string]interface{}{"en-US": json.Number("9007199254740993")}, entries[0].Fields["lifes"])
+}
@@ -0,0 +1,107 @@
+package contentful
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// EnvironmentAliasesService service
+type EnvironmentAliasesService service
+
+// EnvironmentAlias model
+type EnvironmentAlias struct {
+	Sys         *Sys                    `json:"sys,omitempty"`
+	Environment *EnvironmentAliasTarget `json:"environment,omitempty"`
+}
+
+// EnvironmentAliasTarget model, a link to the environment an alias points at
+type EnvironmentAliasTarget struct {
+	Sys *Sys `json:"sys,omitempty"`
+}
+
+// NewEnvironmentAliasTarget returns a link to the given environment
+func NewEnvironmentAliasTarget(environmentID string) *EnvironmentAliasTarget {
+	return &EnvironmentAliasTarget{
+		Sys: &Sys{
+			ID:       environmentID,
+			Type:     "Link",
+			LinkType: "Environment",
+		},
+	}
+}
+
+// GetVersion returns entity version
+func (alias *EnvironmentAlias) GetVersion() int {
+	version := 1
+	if alias.Sys != nil {
+		version = alias.Sys.Version
+	}
+
+	return version
+}
+
+// List returns an environment aliases collection
+func (service *EnvironmentAliasesService) List(spaceID string) *Collection {
+	path := spacePath(spaceID, "/environment_aliases")
+	method := "GET"
+
+	req, err := service.c.newRequest(method, path, nil, nil)
+	if err != nil {
+		return &Collection{}
+	}
+
+	col := NewCollection(&CollectionOptions{})
+	col.c = service.c
+	col.req = req
+
+	return col
+}
+
+// Get returns a single environment alias entity
+func (service *EnvironmentAliasesService) Get(ctx context.Context, spaceID, aliasID string) (*EnvironmentAlias, error) {
+	path := spacePath(spaceID, "/environment_aliases/%s", aliasID)
+	method := "GET"
+
+	req, err := service.c.newRequest(method, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var alias EnvironmentAlias
+	if err := service.c.do(withContext(req, ctx), &alias); err != nil {
+		return nil, err
+	}
+
+	return &alias, nil
+}
+
+// Update points the alias at the environment set in alias.Environment
+func (service *EnvironmentAliasesService) Update(ctx context.Context, spaceID string, alias *EnvironmentAlias) error {
+	if alias.Sys == nil || alias.Sys.ID == "" {
+		return fmt.Errorf("updating an environment alias requires an alias id")
+	}
+
+	bytesArray, err := json.Marshal(&struct {
+		Environment *EnvironmentAliasTarget `json:"environment"`
+	}{
+		Environment: alias.Environment,
+	})
+	if err != nil {
+		return err
+	}
+
+	path := spacePath(spaceID, "/environment_aliases/%s", alias.Sys.ID)
+	method := "PUT"
+
+	req, err := service.c.newRequest(method, path, nil, bytes.NewReader(bytesArray))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("X-Contentful-Version", strconv.Itoa(alias.GetVersion()))
+
+	return service.c.do(withContext(req, ctx), alias)
+}
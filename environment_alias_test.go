@@ -0,0 +1,117 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnvironmentAliasesServiceList(t *testing.T) {
+	var err error
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(r.Method, "GET")
+		assert.Equal(r.URL.Path, "/spaces/"+spaceID+"/environment_aliases")
+
+		checkHeaders(r, assert)
+
+		w.WriteHeader(200)
+		fmt.Fprintln(w, `{"sys": {"type": "Array"}, "total": 1, "skip": 0, "limit": 100, "items": [`+readTestData("environment_alias.json")+`]}`)
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	col, err := cma.EnvironmentAliases.List(spaceID).Next()
+	assert.Nil(err)
+	aliases := col.ToEnvironmentAlias()
+	assert.Equal(1, len(aliases))
+	assert.Equal("release-2", aliases[0].Environment.Sys.ID)
+}
+
+func TestEnvironmentAliasesServiceGet(t *testing.T) {
+	var err error
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(r.Method, "GET")
+		assert.Equal(r.URL.Path, "/spaces/"+spaceID+"/environment_aliases/master")
+
+		checkHeaders(r, assert)
+
+		w.WriteHeader(200)
+		fmt.Fprintln(w, readTestData("environment_alias.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	alias, err := cma.EnvironmentAliases.Get(context.Background(), spaceID, "master")
+	assert.Nil(err)
+	assert.Equal("master", alias.Sys.ID)
+	assert.Equal(2, alias.Sys.Version)
+	assert.Equal("release-2", alias.Environment.Sys.ID)
+}
+
+func TestEnvironmentAliasesServiceUpdate(t *testing.T) {
+	var err error
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(r.Method, "PUT")
+		assert.Equal(r.URL.Path, "/spaces/"+spaceID+"/environment_aliases/master")
+		assert.Equal("2", r.Header.Get("X-Contentful-Version"))
+
+		checkHeaders(r, assert)
+
+		var payload map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assert.Nil(err)
+		environment := payload["environment"].(map[string]interface{})["sys"].(map[string]interface{})
+		assert.Equal("release-2", environment["id"])
+		assert.Equal("Link", environment["type"])
+		assert.Equal("Environment", environment["linkType"])
+
+		w.WriteHeader(200)
+		fmt.Fprintln(w, readTestData("environment_alias.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	alias := &EnvironmentAlias{
+		Sys: &Sys{
+			ID:      "master",
+			Version: 2,
+		},
+		Environment: NewEnvironmentAliasTarget("release-2"),
+	}
+
+	err = cma.EnvironmentAliases.Update(context.Background(), spaceID, alias)
+	assert.Nil(err)
+	assert.Equal("2019-07-02T10:00:00Z", alias.Sys.UpdatedAt)
+
+	err = cma.EnvironmentAliases.Update(context.Background(), spaceID, &EnvironmentAlias{})
+	assert.NotNil(err)
+}
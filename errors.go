@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"net/http"
+	"strings"
 )
 
 // ErrorResponse model
@@ -75,6 +76,63 @@ func (e ValidationFailedError) Error() string {
 	return msg.String()
 }
 
+// ValidationErrorDetail model, a single failed validation of a 422 response
+type ValidationErrorDetail struct {
+	Name    string
+	Path    []interface{}
+	Details string
+}
+
+// Errors returns the failed validations
+func (e ValidationFailedError) Errors() []ValidationErrorDetail {
+	details := []ValidationErrorDetail{}
+
+	if e.APIError.err == nil || e.APIError.err.Details == nil {
+		return details
+	}
+
+	for _, err := range e.APIError.err.Details.Errors {
+		detail := ValidationErrorDetail{
+			Name:    err.Name,
+			Details: err.Details,
+		}
+
+		if path, ok := err.Path.([]interface{}); ok {
+			detail.Path = path
+		} else if err.Path != nil {
+			detail.Path = []interface{}{err.Path}
+		}
+
+		details = append(details, detail)
+	}
+
+	return details
+}
+
+// FieldErrors returns the details of the failed validations keyed by their
+// dotted path, e.g. "fields.title.en-US"
+func (e ValidationFailedError) FieldErrors() map[string]string {
+	fieldErrors := map[string]string{}
+
+	for _, detail := range e.Errors() {
+		path := []string{}
+		for _, segment := range detail.Path {
+			path = append(path, fmt.Sprintf("%v", segment))
+		}
+
+		key := strings.Join(path, ".")
+
+		if existing, ok := fieldErrors[key]; ok {
+			fieldErrors[key] = existing + "\n" + detail.Details
+			continue
+		}
+
+		fieldErrors[key] = detail.Details
+	}
+
+	return fieldErrors
+}
+
 // NotFoundError for 404 errors
 type NotFoundError struct {
 	APIError
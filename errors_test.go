@@ -69,3 +69,49 @@ func TestRateLimitExceededResponse(t *testing.T) {
 	assert.Equal("Error", rateLimitExceededError.APIError.err.Sys.Type)
 	assert.Equal("RateLimitExceeded", rateLimitExceededError.APIError.err.Sys.ID)
 }
+
+func TestValidationFailedErrorResponse(t *testing.T) {
+	var err error
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(422)
+		fmt.Fprintln(w, string(readTestData("error-validationfailed.json")))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	entry := &Entry{
+		Sys: &Sys{
+			ContentType: &ContentType{
+				Sys: &Sys{
+					ID: "article",
+				},
+			},
+		},
+		Fields: map[string]interface{}{},
+	}
+
+	err = cma.Entries.Upsert(spaceID, entry)
+	assert.NotNil(err)
+	validationFailedError, ok := err.(ValidationFailedError)
+	assert.True(ok)
+
+	details := validationFailedError.Errors()
+	assert.Equal(3, len(details))
+	assert.Equal("required", details[0].Name)
+	assert.Equal([]interface{}{"fields", "title"}, details[0].Path)
+	assert.Equal("The property \"title\" is required here", details[0].Details)
+
+	assert.Equal(map[string]string{
+		"fields.title":        "The property \"title\" is required here",
+		"fields.slug.en-US":   "Size must be at most 20",
+		"fields.tags.en-US.1": "Value must be one of expected values",
+	}, validationFailedError.FieldErrors())
+}
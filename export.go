@@ -0,0 +1,403 @@
+package contentful
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+)
+
+// ExportService service
+type ExportService service
+
+// EditorInterface model
+type EditorInterface struct {
+	Sys           *Sys          `json:"sys,omitempty"`
+	Controls      []interface{} `json:"controls,omitempty"`
+	Sidebar       []interface{} `json:"sidebar,omitempty"`
+	Editors       []interface{} `json:"editors,omitempty"`
+	EditorLayout  []interface{} `json:"editorLayout,omitempty"`
+	GroupControls []interface{} `json:"groupControls,omitempty"`
+}
+
+// SpaceSnapshot model, the content model and entries of a space as written
+// by Export and read by Import
+type SpaceSnapshot struct {
+	Locales          []*Locale          `json:"locales"`
+	ContentTypes     []*ContentType     `json:"contentTypes"`
+	EditorInterfaces []*EditorInterface `json:"editorInterfaces"`
+	Entries          []*Entry           `json:"entries"`
+}
+
+// Export writes the locales, content types, editor interfaces and entries of
+// the space to w as a SpaceSnapshot. Entries are written page by page as
+// they are fetched. Assets are not exported.
+func (service *ExportService) Export(ctx context.Context, spaceID string, w io.Writer) error {
+	snapshot := SpaceSnapshot{
+		Locales:          []*Locale{},
+		ContentTypes:     []*ContentType{},
+		EditorInterfaces: []*EditorInterface{},
+	}
+
+	err := eachPage(ctx, service.c.Locales.List(spaceID), func(col *Collection) error {
+		snapshot.Locales = append(snapshot.Locales, col.ToLocale()...)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = eachPage(ctx, service.c.ContentTypes.List(spaceID), func(col *Collection) error {
+		snapshot.ContentTypes = append(snapshot.ContentTypes, col.ToContentType()...)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, ct := range snapshot.ContentTypes {
+		ei, err := service.getEditorInterface(ctx, spaceID, ct.Sys.ID)
+		if err != nil {
+			return err
+		}
+
+		snapshot.EditorInterfaces = append(snapshot.EditorInterfaces, ei)
+	}
+
+	// everything but the entries is written at once, the entries follow
+	head := &bytes.Buffer{}
+	head.WriteByte('{')
+
+	for _, field := range []struct {
+		key   string
+		value interface{}
+	}{
+		{"locales", snapshot.Locales},
+		{"contentTypes", snapshot.ContentTypes},
+		{"editorInterfaces", snapshot.EditorInterfaces},
+	} {
+		byteArray, err := json.Marshal(field.value)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(head, "%q:%s,", field.key, byteArray)
+	}
+
+	head.WriteString(`"entries":[`)
+	if _, err := w.Write(head.Bytes()); err != nil {
+		return err
+	}
+
+	first := true
+	err = eachPage(ctx, service.c.Entries.List(spaceID), func(col *Collection) error {
+		for _, entry := range col.ToEntry() {
+			byteArray, err := json.Marshal(entry)
+			if err != nil {
+				return err
+			}
+
+			if !first {
+				byteArray = append([]byte(","), byteArray...)
+			}
+			first = false
+
+			if _, err := w.Write(byteArray); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write([]byte("]}"))
+
+	return err
+}
+
+// Import recreates the locales, content types, editor interfaces and entries
+// of a SpaceSnapshot read from r in the target space, keeping their ids.
+// Locales existing in the target space already are skipped, content types
+// are activated after the content types they link to, and entries published
+// in the snapshot are published after the entries they link to.
+func (service *ExportService) Import(ctx context.Context, targetSpaceID string, r io.Reader) error {
+	var snapshot SpaceSnapshot
+	if err := json.NewDecoder(r).Decode(&snapshot); err != nil {
+		return err
+	}
+
+	if err := snapshot.validate(); err != nil {
+		return err
+	}
+
+	existing := map[string]bool{}
+	err := eachPage(ctx, service.c.Locales.List(targetSpaceID), func(col *Collection) error {
+		for _, locale := range col.ToLocale() {
+			existing[locale.Code] = true
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, locale := range snapshot.Locales {
+		if existing[locale.Code] {
+			continue
+		}
+
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		imported := *locale
+		imported.Sys = nil
+		imported.Default = false
+
+		if err := service.c.Locales.UpsertContext(ctx, targetSpaceID, &imported); err != nil {
+			return fmt.Errorf("importing locale %s: %w", locale.Code, err)
+		}
+	}
+
+	// all content types are saved before any is activated, as activating a
+	// content type linking to an inactive one fails
+	cts := []*ContentType{}
+	for _, ct := range snapshot.ContentTypes {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		imported := *ct
+		imported.Sys = &Sys{ID: ct.Sys.ID}
+
+		if err := service.c.ContentTypes.UpsertContext(ctx, targetSpaceID, &imported); err != nil {
+			return fmt.Errorf("importing content type %s: %w", ct.Sys.ID, err)
+		}
+
+		cts = append(cts, &imported)
+	}
+
+	if err := service.c.ContentTypes.ActivateAll(ctx, targetSpaceID, cts); err != nil {
+		return fmt.Errorf("activating content types: %w", err)
+	}
+
+	for _, ei := range snapshot.EditorInterfaces {
+		if ei == nil || ei.Sys == nil || ei.Sys.ContentType == nil || ei.Sys.ContentType.Sys == nil {
+			continue
+		}
+
+		contentTypeID := ei.Sys.ContentType.Sys.ID
+
+		current, err := service.getEditorInterface(ctx, targetSpaceID, contentTypeID)
+		if err != nil {
+			return fmt.Errorf("importing editor interface of %s: %w", contentTypeID, err)
+		}
+
+		imported := *ei
+		imported.Sys = current.Sys
+
+		if err := service.putEditorInterface(ctx, targetSpaceID, contentTypeID, &imported); err != nil {
+			return fmt.Errorf("importing editor interface of %s: %w", contentTypeID, err)
+		}
+	}
+
+	for _, entry := range orderEntriesByLinks(snapshot.Entries) {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		imported := &Entry{
+			Sys: &Sys{
+				ID:          entry.Sys.ID,
+				ContentType: entry.Sys.ContentType,
+			},
+			Fields: entry.Fields,
+		}
+
+		contentTypeID := entry.Sys.ContentType.Sys.ID
+		if err := service.c.Entries.Create(ctx, targetSpaceID, contentTypeID, imported); err != nil {
+			return fmt.Errorf("importing entry %s: %w", entry.Sys.ID, err)
+		}
+
+		if entry.Sys.PublishedVersion == 0 && entry.Sys.PublishedAt == "" {
+			continue
+		}
+
+		if err := service.c.Entries.publish(ctx, targetSpaceID, imported); err != nil {
+			return fmt.Errorf("publishing entry %s: %w", entry.Sys.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// validate checks that the snapshot has the ids Import relies on, naming
+// the first item lacking them
+func (snapshot *SpaceSnapshot) validate() error {
+	for i, locale := range snapshot.Locales {
+		if locale == nil || locale.Code == "" {
+			return fmt.Errorf("snapshot locale #%d has no code", i)
+		}
+	}
+
+	for i, ct := range snapshot.ContentTypes {
+		if ct == nil || ct.Sys == nil || ct.Sys.ID == "" {
+			return fmt.Errorf("snapshot content type #%d has no sys.id", i)
+		}
+	}
+
+	for i, entry := range snapshot.Entries {
+		if entry == nil || entry.Sys == nil || entry.Sys.ID == "" {
+			return fmt.Errorf("snapshot entry #%d has no sys.id", i)
+		}
+
+		if entry.Sys.ContentType == nil || entry.Sys.ContentType.Sys == nil || entry.Sys.ContentType.Sys.ID == "" {
+			return fmt.Errorf("snapshot entry %s has no sys.contentType", entry.Sys.ID)
+		}
+	}
+
+	return nil
+}
+
+func (service *ExportService) getEditorInterface(ctx context.Context, spaceID, contentTypeID string) (*EditorInterface, error) {
+	path := service.c.envPath(spaceID, "/content_types/%s/editor_interface", contentTypeID)
+
+	req, err := service.c.newRequest(http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var ei EditorInterface
+	if err := service.c.do(withContext(req, ctx), &ei); err != nil {
+		return nil, err
+	}
+
+	return &ei, nil
+}
+
+func (service *ExportService) putEditorInterface(ctx context.Context, spaceID, contentTypeID string, ei *EditorInterface) error {
+	bytesArray, err := json.Marshal(ei)
+	if err != nil {
+		return err
+	}
+
+	path := service.c.envPath(spaceID, "/content_types/%s/editor_interface", contentTypeID)
+
+	req, err := service.c.newRequest(http.MethodPut, path, nil, bytes.NewReader(bytesArray))
+	if err != nil {
+		return err
+	}
+
+	version := 1
+	if ei.Sys != nil {
+		version = ei.Sys.Version
+	}
+
+	req.Header.Set("X-Contentful-Version", strconv.Itoa(version))
+
+	return service.c.do(withContext(req, ctx), ei)
+}
+
+// eachPage fetches the pages of the collection, calling fn for each of them
+func eachPage(ctx context.Context, col *Collection, fn func(col *Collection) error) error {
+	if col.req == nil {
+		return fmt.Errorf("collection has no request")
+	}
+
+	col.req = withContext(col.req, ctx)
+
+	for {
+		if _, err := col.Next(); err != nil {
+			return err
+		}
+
+		if err := fn(col); err != nil {
+			return err
+		}
+
+		if len(col.Items) == 0 || !col.HasMore() {
+			return nil
+		}
+	}
+}
+
+// orderEntriesByLinks orders the entries so that entries come after the
+// entries they link to. Links in cycles and to entries outside of the given
+// ones are ignored.
+func orderEntriesByLinks(entries []*Entry) []*Entry {
+	byID := map[string]*Entry{}
+	for _, entry := range entries {
+		byID[entry.Sys.ID] = entry
+	}
+
+	ordered := []*Entry{}
+	visited := map[string]bool{}
+	visiting := map[string]bool{}
+
+	var visit func(entry *Entry)
+	visit = func(entry *Entry) {
+		id := entry.Sys.ID
+		if visited[id] || visiting[id] {
+			return
+		}
+
+		visiting[id] = true
+
+		for _, target := range entryLinks(entry.Fields) {
+			if linked, ok := byID[target]; ok {
+				visit(linked)
+			}
+		}
+
+		visiting[id] = false
+		visited[id] = true
+		ordered = append(ordered, entry)
+	}
+
+	for _, entry := range entries {
+		visit(entry)
+	}
+
+	return ordered
+}
+
+// entryLinks returns the ids of the entries linked to in the given value
+func entryLinks(value interface{}) []string {
+	ids := []string{}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if sys, ok := v["sys"].(map[string]interface{}); ok && sys["type"] == "Link" {
+			if id, ok := sys["id"].(string); ok && sys["linkType"] == LinkTypeEntry {
+				ids = append(ids, id)
+			}
+
+			return ids
+		}
+
+		keys := []string{}
+		for key := range v {
+			keys = append(keys, key)
+		}
+
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			ids = append(ids, entryLinks(v[key])...)
+		}
+	case []interface{}:
+		for _, val := range v {
+			ids = append(ids, entryLinks(val)...)
+		}
+	}
+
+	return ids
+}
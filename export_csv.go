@@ -0,0 +1,103 @@
+package contentful
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+)
+
+// ExportCSV writes the entries of the content type to w as CSV, one row per
+// entry with the values of the given locale. The header row holds "id" and
+// the ids of the content type's fields, in the content type's order. Values
+// of fields which are not flat, e.g. links, arrays and locations, are
+// replaced by the placeholder, empty unless given. Omitted fields are left
+// out.
+func (service *ExportService) ExportCSV(ctx context.Context, spaceID, contentTypeID, locale string, w io.Writer, placeholder ...string) error {
+	ct, err := service.c.ContentTypes.get(ctx, spaceID, contentTypeID)
+	if err != nil {
+		return err
+	}
+
+	complexValue := ""
+	if len(placeholder) > 0 {
+		complexValue = placeholder[0]
+	}
+
+	fields := []*Field{}
+	header := []string{"id"}
+	for _, field := range ct.Fields {
+		if field.Omitted {
+			continue
+		}
+
+		fields = append(fields, field)
+		header = append(header, field.ID)
+	}
+
+	writer := csv.NewWriter(w)
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	col := service.c.Entries.List(spaceID)
+	col.Query.ContentType(contentTypeID).Locale(locale)
+
+	err = eachPage(ctx, col, func(col *Collection) error {
+		for _, entry := range col.ToEntry() {
+			row := []string{""}
+			if entry.Sys != nil {
+				row[0] = entry.Sys.ID
+			}
+
+			for _, field := range fields {
+				row = append(row, csvValue(field, entry.Fields[field.ID], complexValue))
+			}
+
+			if err := writer.Write(row); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	writer.Flush()
+
+	return writer.Error()
+}
+
+// csvValue formats the value of a flat field, values of other fields are
+// replaced by the placeholder. Values which do not match the field's type,
+// i.e. locale maps without a value of the exported locale, are left empty.
+func csvValue(field *Field, value interface{}, placeholder string) string {
+	if value == nil {
+		return ""
+	}
+
+	switch field.Type {
+	case FieldTypeSymbol, FieldTypeText, FieldTypeDate:
+		if s, ok := value.(string); ok {
+			return s
+		}
+	case FieldTypeInteger, FieldTypeNumber:
+		switch number := value.(type) {
+		case float64:
+			return strconv.FormatFloat(number, 'f', -1, 64)
+		case json.Number:
+			return number.String()
+		}
+	case FieldTypeBoolean:
+		if b, ok := value.(bool); ok {
+			return strconv.FormatBool(b)
+		}
+	default:
+		return placeholder
+	}
+
+	return ""
+}
@@ -0,0 +1,64 @@
+package contentful
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportServiceExportCSV(t *testing.T) {
+	assert := assert.New(t)
+
+	base := "/spaces/" + spaceID + "/environments/master"
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case base + "/content_types/cat":
+			fmt.Fprintln(w, `{"sys": {"id": "cat"}, "fields": [
+				{"id": "name", "type": "Symbol"},
+				{"id": "lifes", "type": "Integer"},
+				{"id": "bestFriend", "type": "Link", "linkType": "Entry"},
+				{"id": "secret", "type": "Symbol", "omitted": true}
+			]}`)
+		case base + "/entries":
+			assert.Equal("cat", r.URL.Query().Get("content_type"))
+
+			// two pages of one entry each
+			if r.URL.Query().Get("skip") == "1" {
+				fmt.Fprintln(w, `{"total": 2, "skip": 1, "limit": 1, "items": [{"sys": {"id": "happycat"}, "fields": {"name": {"en-US": "Happy, Cat"}}}]}`)
+				return
+			}
+
+			fmt.Fprintln(w, `{"total": 2, "skip": 0, "limit": 1, "items": [{"sys": {"id": "nyancat"}, "fields": {
+				"name": {"en-US": "Nyan Cat", "de-DE": "Nyan Katze"},
+				"lifes": {"en-US": 1337},
+				"bestFriend": {"en-US": {"sys": {"type": "Link", "linkType": "Entry", "id": "happycat"}}},
+				"secret": {"en-US": "rainbows"}
+			}}]}`)
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	cma = NewCMA(CMAToken, WithBaseURL(server.URL))
+
+	var buf bytes.Buffer
+	assert.Nil(cma.Export.ExportCSV(context.Background(), spaceID, "cat", "en-US", &buf, "(link)"))
+	assert.Equal("id,name,lifes,bestFriend\n"+
+		"nyancat,Nyan Cat,1337,(link)\n"+
+		"happycat,\"Happy, Cat\",,\n", buf.String())
+
+	// complex values are left empty by default
+	buf.Reset()
+	assert.Nil(cma.Export.ExportCSV(context.Background(), spaceID, "cat", "de-DE", &buf))
+	assert.Equal("id,name,lifes,bestFriend\n"+
+		"nyancat,Nyan Katze,,\n"+
+		"happycat,,,\n", buf.String())
+}
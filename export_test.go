@@ -0,0 +1,246 @@
+package contentful
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportServiceExport(t *testing.T) {
+	assert := assert.New(t)
+
+	base := "/spaces/" + spaceID + "/environments/master"
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("GET", r.Method)
+
+		switch r.URL.Path {
+		case base + "/locales":
+			fmt.Fprintln(w, `{"total": 1, "items": [{"sys": {"id": "en"}, "code": "en-US", "default": true}]}`)
+		case base + "/content_types":
+			fmt.Fprintln(w, `{"total": 1, "items": [{"sys": {"id": "cat"}, "name": "Cat"}]}`)
+		case base + "/content_types/cat/editor_interface":
+			fmt.Fprintln(w, `{"sys": {"contentType": {"sys": {"id": "cat"}}}, "controls": [{"fieldId": "name", "widgetId": "singleLine"}]}`)
+		case base + "/entries":
+			// two pages of one entry each
+			if r.URL.Query().Get("skip") == "1" {
+				fmt.Fprintln(w, `{"total": 2, "skip": 1, "limit": 1, "items": [{"sys": {"id": "happycat"}, "fields": {"name": {"en-US": "Happy Cat"}}}]}`)
+				return
+			}
+
+			fmt.Fprintln(w, `{"total": 2, "skip": 0, "limit": 1, "items": [{"sys": {"id": "nyancat"}, "fields": {"name": {"en-US": "Nyan Cat"}}}]}`)
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	var buf bytes.Buffer
+	assert.Nil(cma.Export.Export(context.Background(), spaceID, &buf))
+
+	var snapshot SpaceSnapshot
+	assert.Nil(json.Unmarshal(buf.Bytes(), &snapshot))
+	assert.Equal(1, len(snapshot.Locales))
+	assert.Equal("en-US", snapshot.Locales[0].Code)
+	assert.Equal(1, len(snapshot.ContentTypes))
+	assert.Equal("Cat", snapshot.ContentTypes[0].Name)
+	assert.Equal(1, len(snapshot.EditorInterfaces))
+	assert.Equal("cat", snapshot.EditorInterfaces[0].Sys.ContentType.Sys.ID)
+	assert.Equal(2, len(snapshot.Entries))
+	assert.Equal("nyancat", snapshot.Entries[0].Sys.ID)
+	assert.Equal("happycat", snapshot.Entries[1].Sys.ID)
+	assert.Equal(map[string]interface{}{"en-US": "Happy Cat"}, snapshot.Entries[1].Fields["name"])
+}
+
+const importSnapshotJSON = `{
+	"locales": [
+		{"sys": {"id": "en"}, "code": "en-US", "name": "English", "default": true},
+		{"sys": {"id": "tlh"}, "code": "tlh", "name": "Klingon"}
+	],
+	"contentTypes": [{"sys": {"id": "cat", "version": 7}, "name": "Cat"}],
+	"editorInterfaces": [{"sys": {"version": 3, "contentType": {"sys": {"id": "cat"}}}, "controls": [{"fieldId": "name"}]}],
+	"entries": [
+		{"sys": {"id": "nyancat", "publishedVersion": 2, "contentType": {"sys": {"id": "cat"}}}, "fields": {
+			"bestFriend": {"en-US": {"sys": {"type": "Link", "linkType": "Entry", "id": "happycat"}}}
+		}},
+		{"sys": {"id": "happycat", "contentType": {"sys": {"id": "cat"}}}, "fields": {"name": {"en-US": "Happy Cat"}}}
+	]
+}`
+
+// importHandler answers the requests of an import into the environment of
+// base, recording them in requests
+func importHandler(assert *assert.Assertions, base string, requests *[]string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests = append(*requests, r.Method+" "+r.URL.Path)
+
+		switch {
+		case r.URL.Path == base+"/locales" && r.Method == "GET":
+			fmt.Fprintln(w, `{"total": 1, "items": [{"sys": {"id": "en"}, "code": "en-US"}]}`)
+		case r.URL.Path == base+"/locales":
+			var locale map[string]interface{}
+			assert.Nil(json.NewDecoder(r.Body).Decode(&locale))
+			assert.Equal("tlh", locale["code"])
+			fmt.Fprintln(w, `{"sys": {"id": "tlh"}}`)
+		case strings.HasSuffix(r.URL.Path, "/editor_interface") && r.Method == "GET":
+			fmt.Fprintln(w, `{"sys": {"version": 1, "contentType": {"sys": {"id": "cat"}}}}`)
+		case strings.HasSuffix(r.URL.Path, "/editor_interface"):
+			assert.Equal("1", r.Header.Get("X-Contentful-Version"))
+			fmt.Fprintln(w, `{"sys": {"version": 2}}`)
+		case strings.HasSuffix(r.URL.Path, "/published"):
+			assert.Equal("1", r.Header.Get("X-Contentful-Version"))
+			fmt.Fprintln(w, `{"sys": {"version": 2}}`)
+		default:
+			id := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+			fmt.Fprintf(w, `{"sys": {"id": %q, "version": 1}}`, id)
+		}
+	})
+}
+
+func TestExportServiceImport(t *testing.T) {
+	assert := assert.New(t)
+
+	base := "/spaces/" + spaceID + "/environments/master"
+	requests := []string{}
+
+	server := httptest.NewServer(importHandler(assert, base, &requests))
+	defer server.Close()
+
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	assert.Nil(cma.Export.Import(context.Background(), spaceID, strings.NewReader(importSnapshotJSON)))
+
+	// happycat is linked to by nyancat and imported first
+	assert.Equal([]string{
+		"GET " + base + "/locales",
+		"POST " + base + "/locales",
+		"PUT " + base + "/content_types/cat",
+		"PUT " + base + "/content_types/cat/published",
+		"GET " + base + "/content_types/cat/editor_interface",
+		"PUT " + base + "/content_types/cat/editor_interface",
+		"PUT " + base + "/entries/happycat",
+		"PUT " + base + "/entries/nyancat",
+		"PUT " + base + "/entries/nyancat/published",
+	}, requests)
+}
+
+func TestExportServiceImportRequestEnvironment(t *testing.T) {
+	assert := assert.New(t)
+
+	base := "/spaces/" + spaceID + "/environments/staging"
+	requests := []string{}
+
+	server := httptest.NewServer(importHandler(assert, base, &requests))
+	defer server.Close()
+
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	ctx := WithRequestEnvironment(context.Background(), "staging")
+	assert.Nil(cma.Export.Import(ctx, spaceID, strings.NewReader(importSnapshotJSON)))
+
+	assert.Equal(9, len(requests))
+	for _, request := range requests {
+		assert.Contains(request, " "+base+"/", "every request goes to the staging environment")
+	}
+}
+
+func TestExportServiceImportContentTypeOrder(t *testing.T) {
+	assert := assert.New(t)
+
+	// b links to a, and comes first
+	snapshot := `{
+		"locales": [],
+		"contentTypes": [
+			{"sys": {"id": "b"}, "name": "B", "fields": [
+				{"id": "a", "name": "A", "type": "Link", "linkType": "Entry", "validations": [{"linkContentType": ["a"]}]}
+			]},
+			{"sys": {"id": "a"}, "name": "A"}
+		],
+		"editorInterfaces": [],
+		"entries": []
+	}`
+
+	base := "/spaces/" + spaceID + "/environments/master"
+	requests := []string{}
+
+	server := httptest.NewServer(importHandler(assert, base, &requests))
+	defer server.Close()
+
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	assert.Nil(cma.Export.Import(context.Background(), spaceID, strings.NewReader(snapshot)))
+
+	// both are saved before a is activated ahead of b
+	assert.Equal([]string{
+		"GET " + base + "/locales",
+		"PUT " + base + "/content_types/b",
+		"PUT " + base + "/content_types/a",
+		"PUT " + base + "/content_types/a/published",
+		"PUT " + base + "/content_types/b/published",
+	}, requests)
+}
+
+func TestExportServiceImportMalformed(t *testing.T) {
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	tests := map[string]string{
+		`{"locales": [null]}`:                                          "snapshot locale #0 has no code",
+		`{"contentTypes": [{"sys": {"id": "cat"}}, {}]}`:               "snapshot content type #1 has no sys.id",
+		`{"entries": [{"fields": {}}]}`:                                "snapshot entry #0 has no sys.id",
+		`{"entries": [{"sys": {"id": "nyancat"}}]}`:                    "snapshot entry nyancat has no sys.contentType",
+		`{"entries": [{"sys": {"id": "nyancat", "contentType": {}}}]}`: "snapshot entry nyancat has no sys.contentType",
+	}
+
+	for snapshot, message := range tests {
+		err := cma.Export.Import(context.Background(), spaceID, strings.NewReader(snapshot))
+		assert.EqualError(err, message, snapshot)
+	}
+}
+
+func TestOrderEntriesByLinks(t *testing.T) {
+	assert := assert.New(t)
+
+	link := func(id string) map[string]interface{} {
+		return map[string]interface{}{
+			"sys": map[string]interface{}{"type": "Link", "linkType": "Entry", "id": id},
+		}
+	}
+
+	entries := []*Entry{
+		{Sys: &Sys{ID: "a"}, Fields: map[string]interface{}{"friends": map[string]interface{}{"en-US": []interface{}{link("b"), link("c")}}}},
+		{Sys: &Sys{ID: "b"}, Fields: map[string]interface{}{"friend": map[string]interface{}{"en-US": link("c")}}},
+		{Sys: &Sys{ID: "c"}, Fields: map[string]interface{}{"friend": map[string]interface{}{"en-US": link("a")}}},
+		{Sys: &Sys{ID: "d"}, Fields: map[string]interface{}{"friend": map[string]interface{}{"en-US": link("missing")}}},
+	}
+
+	ids := []string{}
+	for _, entry := range orderEntriesByLinks(entries) {
+		ids = append(ids, entry.Sys.ID)
+	}
+
+	// the link from c back to a closes a cycle and is ignored
+	assert.Equal([]string{"c", "b", "a", "d"}, ids)
+}
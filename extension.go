@@ -0,0 +1,153 @@
+package contentful
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+)
+
+// UIExtensionsService service
+type UIExtensionsService service
+
+// Extension model, a ui extension. `Parameters` holds the values of the
+// installation parameters defined in `Extension.Parameters`.
+type Extension struct {
+	Sys        *Sys                   `json:"sys,omitempty"`
+	Extension  *ExtensionDetails      `json:"extension,omitempty"`
+	Parameters map[string]interface{} `json:"parameters,omitempty"`
+}
+
+// ExtensionDetails model. The extension is either hosted at `Src` or
+// embedded as html in `SrcDoc`.
+type ExtensionDetails struct {
+	Name       string                `json:"name"`
+	FieldTypes []*ExtensionFieldType `json:"fieldTypes,omitempty"`
+	Src        string                `json:"src,omitempty"`
+	SrcDoc     string                `json:"srcdoc,omitempty"`
+	Sidebar    bool                  `json:"sidebar,omitempty"`
+	Parameters *ExtensionParameters  `json:"parameters,omitempty"`
+}
+
+// ExtensionFieldType model, a field type the extension can edit
+type ExtensionFieldType struct {
+	Type     string              `json:"type"`
+	LinkType string              `json:"linkType,omitempty"`
+	Items    *FieldTypeArrayItem `json:"items,omitempty"`
+}
+
+// ExtensionParameters model, the parameter definitions of an extension
+type ExtensionParameters struct {
+	Instance     []*ExtensionParameter `json:"instance,omitempty"`
+	Installation []*ExtensionParameter `json:"installation,omitempty"`
+}
+
+// ExtensionParameter model
+type ExtensionParameter struct {
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	Description string            `json:"description,omitempty"`
+	Type        string            `json:"type"`
+	Required    bool              `json:"required,omitempty"`
+	Default     interface{}       `json:"default,omitempty"`
+	Options     []interface{}     `json:"options,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+}
+
+// GetVersion returns entity version
+func (extension *Extension) GetVersion() int {
+	version := 1
+	if extension.Sys != nil {
+		version = extension.Sys.Version
+	}
+
+	return version
+}
+
+// List returns an extensions collection
+func (service *UIExtensionsService) List(spaceID string) *Collection {
+	path := service.c.envPath(spaceID, "/extensions")
+	method := "GET"
+
+	req, err := service.c.newRequest(method, path, nil, nil)
+	if err != nil {
+		return &Collection{}
+	}
+
+	col := NewCollection(&CollectionOptions{})
+	col.c = service.c
+	col.req = req
+
+	return col
+}
+
+// Get returns a single extension entity
+func (service *UIExtensionsService) Get(spaceID, extensionID string) (*Extension, error) {
+	path := service.c.envPath(spaceID, "/extensions/%s", extensionID)
+	method := "GET"
+
+	req, err := service.c.newRequest(method, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var extension Extension
+	if err := service.c.do(req, &extension); err != nil {
+		return nil, err
+	}
+
+	return &extension, nil
+}
+
+// Upsert updates or creates a new extension. Extensions which have been
+// created before, i.e. have `Sys.CreatedAt` set, are updated, others are
+// created with the id of `extension.Sys.ID` when set.
+func (service *UIExtensionsService) Upsert(spaceID string, extension *Extension) error {
+	bytesArray, err := json.Marshal(&struct {
+		Extension  *ExtensionDetails      `json:"extension"`
+		Parameters map[string]interface{} `json:"parameters,omitempty"`
+	}{
+		Extension:  extension.Extension,
+		Parameters: extension.Parameters,
+	})
+	if err != nil {
+		return err
+	}
+
+	var path apiPath
+	var method string
+
+	if extension.Sys != nil && extension.Sys.ID != "" {
+		path = service.c.envPath(spaceID, "/extensions/%s", extension.Sys.ID)
+		method = "PUT"
+	} else {
+		path = service.c.envPath(spaceID, "/extensions")
+		method = "POST"
+	}
+
+	req, err := service.c.newRequest(method, path, nil, bytes.NewReader(bytesArray))
+	if err != nil {
+		return err
+	}
+
+	if extension.Sys != nil && extension.Sys.CreatedAt != "" {
+		req.Header.Set("X-Contentful-Version", strconv.Itoa(extension.GetVersion()))
+	}
+
+	return service.c.do(req, extension)
+}
+
+// Delete the extension
+func (service *UIExtensionsService) Delete(spaceID string, extension *Extension) error {
+	path := service.c.envPath(spaceID, "/extensions/%s", extension.Sys.ID)
+	method := "DELETE"
+
+	req, err := service.c.newRequest(method, path, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	version := strconv.Itoa(extension.Sys.Version)
+	req.Header.Set("X-Contentful-Version", version)
+
+	return service.c.do(req, nil)
+}
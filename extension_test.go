@@ -0,0 +1,153 @@
+package contentful
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUIExtensionsServiceList(t *testing.T) {
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("GET", r.Method)
+		assert.Equal("/spaces/"+spaceID+"/environments/master/extensions", r.URL.Path)
+		checkHeaders(r, assert)
+
+		w.WriteHeader(200)
+		fmt.Fprintln(w, `{"sys": {"type": "Array"}, "total": 1, "items": [`+readTestData("extension.json")+`]}`)
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	col, err := cma.UIExtensions.List(spaceID).Next()
+	assert.Nil(err)
+
+	extensions := col.ToExtension()
+	assert.Equal(1, len(extensions))
+	assert.Equal("Color picker", extensions[0].Extension.Name)
+	assert.Equal(FieldTypeArray, extensions[0].Extension.FieldTypes[1].Type)
+	assert.Equal(FieldTypeSymbol, extensions[0].Extension.FieldTypes[1].Items.Type)
+	assert.Equal("palette", extensions[0].Extension.Parameters.Instance[0].ID)
+}
+
+func TestUIExtensionsServiceGet(t *testing.T) {
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("GET", r.Method)
+		assert.Equal("/spaces/"+spaceID+"/environments/master/extensions/color-picker", r.URL.Path)
+		checkHeaders(r, assert)
+
+		w.WriteHeader(200)
+		fmt.Fprintln(w, readTestData("extension.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	extension, err := cma.UIExtensions.Get(spaceID, "color-picker")
+	assert.Nil(err)
+	assert.Equal(3, extension.Sys.Version)
+	assert.Equal("https://example.com/color-picker.html", extension.Extension.Src)
+}
+
+func TestUIExtensionsServiceUpsert(t *testing.T) {
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		checkHeaders(r, assert)
+
+		var payload map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assert.Nil(err)
+		_, ok := payload["sys"]
+		assert.False(ok)
+
+		extension := payload["extension"].(map[string]interface{})
+		assert.Equal("Color picker", extension["name"])
+		assert.Equal("<html></html>", extension["srcdoc"])
+		assert.Equal(true, extension["sidebar"])
+
+		switch r.URL.Path {
+		case "/spaces/" + spaceID + "/environments/master/extensions":
+			assert.Equal("POST", r.Method)
+			assert.Equal("", r.Header.Get("X-Contentful-Version"))
+			w.WriteHeader(201)
+		case "/spaces/" + spaceID + "/environments/master/extensions/color-picker":
+			assert.Equal("PUT", r.Method)
+			assert.Equal("3", r.Header.Get("X-Contentful-Version"))
+			w.WriteHeader(200)
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+
+		fmt.Fprintln(w, readTestData("extension.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	extension := &Extension{
+		Extension: &ExtensionDetails{
+			Name:       "Color picker",
+			FieldTypes: []*ExtensionFieldType{&ExtensionFieldType{Type: FieldTypeSymbol}},
+			SrcDoc:     "<html></html>",
+			Sidebar:    true,
+		},
+	}
+
+	err := cma.UIExtensions.Upsert(spaceID, extension)
+	assert.Nil(err)
+	assert.Equal("color-picker", extension.Sys.ID)
+
+	extension.Extension.SrcDoc = "<html></html>"
+	extension.Extension.Sidebar = true
+
+	err = cma.UIExtensions.Upsert(spaceID, extension)
+	assert.Nil(err)
+}
+
+func TestUIExtensionsServiceDelete(t *testing.T) {
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("DELETE", r.Method)
+		assert.Equal("/spaces/"+spaceID+"/environments/master/extensions/color-picker", r.URL.Path)
+		assert.Equal("3", r.Header.Get("X-Contentful-Version"))
+		checkHeaders(r, assert)
+
+		w.WriteHeader(204)
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	err := cma.UIExtensions.Delete(spaceID, &Extension{Sys: &Sys{ID: "color-picker", Version: 3}})
+	assert.Nil(err)
+}
@@ -0,0 +1,120 @@
+package contentful
+
+// FieldBuilder builds content type fields, e.g.
+//
+//	field := NewFieldBuilder("friends", "Friends", FieldTypeArray).
+//		ArrayOf(FieldTypeLink).
+//		LinkTo("cat", "dog").
+//		Localized().
+//		Build()
+//
+// Field has properties named Required, Localized and Disabled, so the
+// builder is a type of its own rather than methods on Field.
+type FieldBuilder struct {
+	field *Field
+}
+
+// NewFieldBuilder returns a builder for a field with the given id, name and
+// type
+func NewFieldBuilder(id, name, fieldType string) *FieldBuilder {
+	return &FieldBuilder{
+		field: &Field{
+			ID:   id,
+			Name: name,
+			Type: fieldType,
+		},
+	}
+}
+
+// Required makes the field required
+func (b *FieldBuilder) Required() *FieldBuilder {
+	b.field.Required = true
+	return b
+}
+
+// Localized makes the field hold a value per locale
+func (b *FieldBuilder) Localized() *FieldBuilder {
+	b.field.Localized = true
+	return b
+}
+
+// Disabled disables editing the field
+func (b *FieldBuilder) Disabled() *FieldBuilder {
+	b.field.Disabled = true
+	return b
+}
+
+// WithValidation adds the given validation, to the items of an array field
+func (b *FieldBuilder) WithValidation(validation FieldValidation) *FieldBuilder {
+	if b.field.Items != nil {
+		b.field.Items.Validations = append(b.field.Items.Validations, validation)
+		return b
+	}
+
+	b.field.Validations = append(b.field.Validations, validation)
+	return b
+}
+
+// ArrayOf makes the field an array of items of the given type, Symbol or
+// Link
+func (b *FieldBuilder) ArrayOf(itemType string) *FieldBuilder {
+	b.field.Type = FieldTypeArray
+	b.field.Items = &FieldTypeArrayItem{
+		Type: itemType,
+	}
+
+	return b
+}
+
+// LinkTo makes the field, or the items of an array field, link to entries,
+// restricted to the given content types when any are given
+func (b *FieldBuilder) LinkTo(contentTypeIDs ...string) *FieldBuilder {
+	b.setLinkType(LinkTypeEntry)
+
+	if len(contentTypeIDs) > 0 {
+		b.WithValidation(FieldValidationLink{
+			LinkContentType: contentTypeIDs,
+		})
+	}
+
+	return b
+}
+
+// LinkToAsset makes the field, or the items of an array field, link to
+// assets
+func (b *FieldBuilder) LinkToAsset() *FieldBuilder {
+	b.setLinkType(LinkTypeAsset)
+	return b
+}
+
+func (b *FieldBuilder) setLinkType(linkType string) {
+	if b.field.Items != nil {
+		b.field.Items.Type = FieldTypeLink
+		b.field.Items.LinkType = linkType
+		return
+	}
+
+	b.field.Type = FieldTypeLink
+	b.field.LinkType = linkType
+}
+
+// Build returns the field. Link fields and arrays of links without a link
+// type link to entries.
+func (b *FieldBuilder) Build() *Field {
+	field := *b.field
+
+	if field.Items != nil {
+		items := *field.Items
+		if items.Type == FieldTypeLink && items.LinkType == "" {
+			items.LinkType = LinkTypeEntry
+		}
+
+		field.Items = &items
+	}
+
+	if field.Type == FieldTypeLink && field.LinkType == "" {
+		field.LinkType = LinkTypeEntry
+	}
+
+	return &field
+}
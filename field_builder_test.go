@@ -0,0 +1,64 @@
+package contentful
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFieldBuilder(t *testing.T) {
+	assert := assert.New(t)
+
+	name := NewFieldBuilder("name", "Name", FieldTypeSymbol).
+		Required().
+		Localized().
+		WithValidation(FieldValidationUnique{Unique: true}).
+		Build()
+
+	assert.Equal(&Field{
+		ID:          "name",
+		Name:        "Name",
+		Type:        FieldTypeSymbol,
+		Required:    true,
+		Localized:   true,
+		Validations: []FieldValidation{FieldValidationUnique{Unique: true}},
+	}, name)
+
+	friend := NewFieldBuilder("bestFriend", "Best friend", FieldTypeLink).LinkTo("cat").Build()
+	assert.Equal(&Field{
+		ID:          "bestFriend",
+		Name:        "Best friend",
+		Type:        FieldTypeLink,
+		LinkType:    "Entry",
+		Validations: []FieldValidation{FieldValidationLink{LinkContentType: []string{"cat"}}},
+	}, friend)
+
+	// link fields without a link type link to entries
+	assert.Equal("Entry", NewFieldBuilder("friend", "Friend", FieldTypeLink).Build().LinkType)
+	assert.Equal("Asset", NewFieldBuilder("image", "Image", FieldTypeLink).LinkToAsset().Build().LinkType)
+
+	friends := NewFieldBuilder("friends", "Friends", FieldTypeArray).
+		ArrayOf(FieldTypeLink).
+		LinkTo("cat", "dog").
+		Disabled().
+		Build()
+
+	byteArray, err := json.Marshal(friends)
+	assert.Nil(err)
+	assert.JSONEq(`{
+		"id": "friends",
+		"name": "Friends",
+		"type": "Array",
+		"disabled": true,
+		"omitted": false,
+		"items": {
+			"type": "Link",
+			"linkType": "Entry",
+			"validations": [{"linkContentType": ["cat", "dog"]}]
+		}
+	}`, string(byteArray))
+
+	tags := NewFieldBuilder("tags", "Tags", FieldTypeArray).ArrayOf(FieldTypeSymbol).Build()
+	assert.Equal(&FieldTypeArrayItem{Type: FieldTypeSymbol}, tags.Items)
+}
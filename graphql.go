@@ -0,0 +1,132 @@
+package contentful
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// GraphQLClient model, a client for the GraphQL Content API
+type GraphQLClient struct {
+	client      *http.Client
+	token       string
+	spaceID     string
+	environment string
+	Headers     map[string]string
+	BaseURL     string
+}
+
+// GraphQLError is returned when the response of a query carries errors. The
+// data of a partially successful query is still decoded.
+type GraphQLError struct {
+	Errors []*GraphQLErrorDetail `json:"errors"`
+}
+
+func (e GraphQLError) Error() string {
+	messages := []string{}
+	for _, err := range e.Errors {
+		messages = append(messages, err.Message)
+	}
+
+	return strings.Join(messages, "\n")
+}
+
+// GraphQLErrorDetail model
+type GraphQLErrorDetail struct {
+	Message    string                  `json:"message"`
+	Locations  []*GraphQLErrorLocation `json:"locations,omitempty"`
+	Path       []interface{}           `json:"path,omitempty"`
+	Extensions map[string]interface{}  `json:"extensions,omitempty"`
+}
+
+// GraphQLErrorLocation model
+type GraphQLErrorLocation struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+// NewGraphQL returns a GraphQL Content API client for the given space
+// environment, master when the environment is empty
+func NewGraphQL(spaceID, environment, token string) *GraphQLClient {
+	if environment == "" {
+		environment = defaultEnvironment
+	}
+
+	return &GraphQLClient{
+		client:      http.DefaultClient,
+		token:       token,
+		spaceID:     spaceID,
+		environment: environment,
+		Headers: map[string]string{
+			"Authorization":           "Bearer " + token,
+			"Content-Type":            "application/json",
+			"X-Contentful-User-Agent": fmt.Sprintf("sdk contentful-go/%s", Version),
+		},
+		BaseURL: "https://graphql.contentful.com",
+	}
+}
+
+// SetHTTPClient sets the underlying http.Client used to make requests.
+func (c *GraphQLClient) SetHTTPClient(client *http.Client) {
+	c.client = client
+}
+
+// Query runs the query with the given variables and decodes the `data` of
+// the response into out
+func (c *GraphQLClient) Query(ctx context.Context, query string, variables map[string]interface{}, out interface{}) error {
+	bytesArray, err := json.Marshal(map[string]interface{}{
+		"query":     query,
+		"variables": variables,
+	})
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%s/content/v1/spaces/%s/environments/%s", c.BaseURL, c.spaceID, c.environment)
+
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(bytesArray))
+	if err != nil {
+		return err
+	}
+
+	for key, value := range c.Headers {
+		req.Header.Set(key, value)
+	}
+
+	res, err := c.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer closeBody(res.Body)
+
+	var payload struct {
+		Data   json.RawMessage       `json:"data"`
+		Errors []*GraphQLErrorDetail `json:"errors"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&payload); err != nil {
+		if res.StatusCode < 200 || res.StatusCode >= 400 {
+			return fmt.Errorf("graphql request failed with status %d", res.StatusCode)
+		}
+
+		return err
+	}
+
+	if out != nil && len(payload.Data) > 0 && string(payload.Data) != "null" {
+		if err := json.Unmarshal(payload.Data, out); err != nil {
+			return err
+		}
+	}
+
+	if len(payload.Errors) > 0 {
+		return GraphQLError{Errors: payload.Errors}
+	}
+
+	if res.StatusCode < 200 || res.StatusCode >= 400 {
+		return fmt.Errorf("graphql request failed with status %d", res.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func ExampleGraphQLClient_Query() {
+	gql := NewGraphQL("space-id", "master", "cda-token")
+
+	var data struct {
+		CatCollection struct {
+			Items []struct {
+				Name string `json:"name"`
+			} `json:"items"`
+		} `json:"catCollection"`
+	}
+
+	err := gql.Query(context.Background(), `query { catCollection { items { name } } }`, nil, &data)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, cat := range data.CatCollection.Items {
+		fmt.Println(cat.Name)
+	}
+}
+
+func TestGraphQLQuery(t *testing.T) {
+	var err error
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(r.Method, "POST")
+		assert.Equal(r.URL.Path, "/content/v1/spaces/"+spaceID+"/environments/staging")
+		assert.Equal("Bearer "+CDAToken, r.Header.Get("Authorization"))
+		assert.Equal("application/json", r.Header.Get("Content-Type"))
+
+		var payload map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assert.Nil(err)
+		assert.Equal("query($id: String!) { cat(id: $id) { name } }", payload["query"])
+		assert.Equal(map[string]interface{}{"id": "nyancat"}, payload["variables"])
+
+		w.WriteHeader(200)
+		fmt.Fprintln(w, `{"data":{"cat":{"name":"Nyan Cat"}}}`)
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	gql := NewGraphQL(spaceID, "staging", CDAToken)
+	gql.BaseURL = server.URL
+
+	var data struct {
+		Cat struct {
+			Name string `json:"name"`
+		} `json:"cat"`
+	}
+
+	err = gql.Query(context.Background(), "query($id: String!) { cat(id: $id) { name } }", map[string]interface{}{"id": "nyancat"}, &data)
+	assert.Nil(err)
+	assert.Equal("Nyan Cat", data.Cat.Name)
+}
+
+func TestGraphQLQueryErrors(t *testing.T) {
+	var err error
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(200)
+		fmt.Fprintln(w, `{
+			"data": {"cat": null},
+			"errors": [{
+				"message": "Query cannot be executed. The maximum allowed complexity for a query is 11000 but it was 22000.",
+				"locations": [{"line": 1, "column": 9}],
+				"path": ["cat"],
+				"extensions": {"contentful": {"code": "TOO_COMPLEX_QUERY"}}
+			}]
+		}`)
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	gql := NewGraphQL(spaceID, "master", CDAToken)
+	gql.BaseURL = server.URL
+
+	var data map[string]interface{}
+	err = gql.Query(context.Background(), "query { cat(id: \"nyancat\") { name } }", nil, &data)
+	assert.NotNil(err)
+	assert.Equal(map[string]interface{}{"cat": nil}, data)
+
+	gqlError, ok := err.(GraphQLError)
+	assert.True(ok)
+	assert.Equal(1, len(gqlError.Errors))
+	assert.Equal(1, gqlError.Errors[0].Locations[0].Line)
+	assert.Equal([]interface{}{"cat"}, gqlError.Errors[0].Path)
+	assert.Equal("Query cannot be executed. The maximum allowed complexity for a query is 11000 but it was 22000.", err.Error())
+}
+
+func TestGraphQLDefaultEnvironment(t *testing.T) {
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("/content/v1/spaces/"+spaceID+"/environments/master", r.URL.Path)
+		fmt.Fprintln(w, `{"data": {}}`)
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	gql := NewGraphQL(spaceID, "", CDAToken)
+	gql.BaseURL = server.URL
+
+	var data map[string]interface{}
+	assert.Nil(gql.Query(context.Background(), "query { __typename }", nil, &data))
+}
@@ -0,0 +1,17 @@
+package contentful
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+)
+
+// IdempotentID derives an entity id from an idempotency key.
+//
+// The api has no idempotency header. Creating an entity with a caller chosen
+// id is idempotent though: a replayed create of an existing id is rejected
+// with a VersionMismatchError instead of creating a duplicate. The
+// CreateIdempotent methods of the entries and assets services build on this.
+func IdempotentID(key string) string {
+	sum := sha1.Sum([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
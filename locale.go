@@ -2,8 +2,8 @@ package contentful
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
-	"fmt"
 	"strconv"
 )
 
@@ -48,7 +48,7 @@ func (locale *Locale) GetVersion() int {
 
 // List returns a locales collection
 func (service *LocalesService) List(spaceID string) *Collection {
-	path := fmt.Sprintf("/spaces/%s/locales", spaceID)
+	path := service.c.envPath(spaceID, "/locales")
 	method := "GET"
 
 	req, err := service.c.newRequest(method, path, nil, nil)
@@ -65,7 +65,7 @@ func (service *LocalesService) List(spaceID string) *Collection {
 
 // Get returns a single locale entity
 func (service *LocalesService) Get(spaceID, localeID string) (*Locale, error) {
-	path := fmt.Sprintf("/spaces/%s/locales/%s", spaceID, localeID)
+	path := service.c.envPath(spaceID, "/locales/%s", localeID)
 	method := "GET"
 
 	req, err := service.c.newRequest(method, path, nil, nil)
@@ -83,7 +83,7 @@ func (service *LocalesService) Get(spaceID, localeID string) (*Locale, error) {
 
 // Delete the locale
 func (service *LocalesService) Delete(spaceID string, locale *Locale) error {
-	path := fmt.Sprintf("/spaces/%s/locales/%s", spaceID, locale.Sys.ID)
+	path := service.c.envPath(spaceID, "/locales/%s", locale.Sys.ID)
 	method := "DELETE"
 
 	req, err := service.c.newRequest(method, path, nil, nil)
@@ -99,19 +99,29 @@ func (service *LocalesService) Delete(spaceID string, locale *Locale) error {
 
 // Upsert updates or creates a new locale entity
 func (service *LocalesService) Upsert(spaceID string, locale *Locale) error {
+	return service.upsert(context.Background(), spaceID, locale)
+}
+
+// UpsertContext updates or creates the locale like Upsert, the request is made
+// with ctx
+func (service *LocalesService) UpsertContext(ctx context.Context, spaceID string, locale *Locale) error {
+	return service.upsert(ctx, spaceID, locale)
+}
+
+func (service *LocalesService) upsert(ctx context.Context, spaceID string, locale *Locale) error {
 	bytesArray, err := json.Marshal(locale)
 	if err != nil {
 		return err
 	}
 
-	var path string
+	var path apiPath
 	var method string
 
 	if locale.Sys != nil && locale.Sys.CreatedAt != "" {
-		path = fmt.Sprintf("/spaces/%s/locales/%s", spaceID, locale.Sys.ID)
+		path = service.c.envPath(spaceID, "/locales/%s", locale.Sys.ID)
 		method = "PUT"
 	} else {
-		path = fmt.Sprintf("/spaces/%s/locales", spaceID)
+		path = service.c.envPath(spaceID, "/locales")
 		method = "POST"
 	}
 
@@ -120,7 +130,7 @@ func (service *LocalesService) Upsert(spaceID string, locale *Locale) error {
 		return err
 	}
 
-	req.Header.Set("X-Contentful-Version", strconv.Itoa(locale.GetVersion()))
+	setVersionHeader(req, locale.Sys)
 
-	return service.c.do(req, locale)
+	return service.c.do(withContext(req, ctx), locale)
 }
@@ -0,0 +1,18 @@
+package contentful
+
+import (
+	"time"
+)
+
+// Metrics observes every request made by a client, e.g. to count requests
+// and record their latency per endpoint and status. The path is given as a
+// template with the ids replaced by {id}, e.g. /spaces/{id}/entries/{id}, so
+// that it is fit for a metric label. The status is 0 when no response was
+// received. Retries are observed as part of the request they are made for.
+type Metrics interface {
+	ObserveRequest(method, pathTemplate string, status int, dur time.Duration)
+}
+
+type noopMetrics struct{}
+
+func (noopMetrics) ObserveRequest(method, pathTemplate string, status int, dur time.Duration) {}
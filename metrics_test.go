@@ -0,0 +1,61 @@
+package contentful
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type observation struct {
+	method       string
+	pathTemplate string
+	status       int
+}
+
+type fakeMetrics struct {
+	mu           sync.Mutex
+	observations []observation
+}
+
+func (m *fakeMetrics) ObserveRequest(method, pathTemplate string, status int, dur time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.observations = append(m.observations, observation{method, pathTemplate, status})
+}
+
+func TestMetrics(t *testing.T) {
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprintln(w, readTestData("error-notfound.json"))
+			return
+		}
+
+		fmt.Fprintln(w, readTestData("entry_3.json"))
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	metrics := &fakeMetrics{}
+	cma := NewCMA(CMAToken, WithBaseURL(server.URL), WithMetrics(metrics))
+
+	_, err := cma.Entries.Get(spaceID, "5KsDBWseXY6QegucYAoacS")
+	assert.Nil(err)
+
+	err = cma.Entries.Delete(spaceID, "5KsDBWseXY6QegucYAoacS")
+	assert.IsType(NotFoundError{}, err)
+
+	assert.Equal([]observation{
+		{"GET", "/spaces/{id}/environments/{id}/entries/{id}", 200},
+		{"DELETE", "/spaces/{id}/environments/{id}/entries/{id}", 404},
+	}, metrics.observations)
+}
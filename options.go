@@ -0,0 +1,133 @@
+package contentful
+
+import (
+	"log"
+	"net/http"
+)
+
+// Option configures a client at construction, e.g.
+//
+//	cma := NewCMA(token, WithEnvironment("staging"), WithMaxRetries(3))
+type Option func(c *Client)
+
+// WithBaseURL sets the base url requests are sent to
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		c.BaseURL = baseURL
+	}
+}
+
+// WithUploadURL sets the base url uploads are sent to
+func WithUploadURL(uploadURL string) Option {
+	return func(c *Client) {
+		c.UploadURL = uploadURL
+	}
+}
+
+// WithDeliveryURL sets the base url of the delivery api, which CDA clients
+// send their requests to unless WithBaseURL is given
+func WithDeliveryURL(deliveryURL string) Option {
+	return func(c *Client) {
+		c.DeliveryURL = deliveryURL
+	}
+}
+
+// WithPreviewURL sets the base url of the preview api, which CPA clients send
+// their requests to unless WithBaseURL is given
+func WithPreviewURL(previewURL string) Option {
+	return func(c *Client) {
+		c.PreviewURL = previewURL
+	}
+}
+
+// WithEnvironment sets the environment requests are scoped to
+func WithEnvironment(environment string) Option {
+	return func(c *Client) {
+		c.Environment = environment
+	}
+}
+
+// WithHTTPClient sets the underlying http.Client used to make requests
+func WithHTTPClient(client *http.Client) Option {
+	return func(c *Client) {
+		c.client = client
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.Headers["User-Agent"] = userAgent
+	}
+}
+
+// WithMaxRetries retries failed requests up to the given number of times with
+// the default retry policy, 0 disables retries
+func WithMaxRetries(retries int) Option {
+	return func(c *Client) {
+		if retries <= 0 {
+			c.retryPolicy = nil
+			return
+		}
+
+		policy := NewDefaultRetryPolicy()
+		policy.MaxAttempts = retries + 1
+		c.retryPolicy = policy
+	}
+}
+
+// WithLogger sets the logger the debug output is written to instead of
+// stdout
+func WithLogger(logger *log.Logger) Option {
+	return func(c *Client) {
+		c.logger = logger
+	}
+}
+
+// WithRetryPolicy sets the policy failed requests are retried by
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Client) {
+		c.retryPolicy = policy
+	}
+}
+
+// WithUseNumber decodes the numbers of entry fields as json.Number instead of
+// float64, so that integers beyond 2^53 keep their precision. Note that field
+// values of entries read by the client are then json.Number rather than
+// float64, EntryField.AsInt and AsFloat handle both.
+func WithUseNumber() Option {
+	return func(c *Client) {
+		c.useNumber = true
+	}
+}
+
+// WithContentTypeValidation validates content types with ContentType.Lint
+// before they are upserted, Upsert returns a ValidationFailedError without
+// sending the request when a check fails
+func WithContentTypeValidation() Option {
+	return func(c *Client) {
+		c.validateCTs = true
+	}
+}
+
+// WithTracer sets the tracer a span is started with around every request
+func WithTracer(tracer Tracer) Option {
+	return func(c *Client) {
+		if tracer == nil {
+			tracer = noopTracer{}
+		}
+
+		c.tracer = tracer
+	}
+}
+
+// WithMetrics sets the metrics every request is observed by
+func WithMetrics(metrics Metrics) Option {
+	return func(c *Client) {
+		if metrics == nil {
+			metrics = noopMetrics{}
+		}
+
+		c.metrics = metrics
+	}
+}
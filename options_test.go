@@ -0,0 +1,111 @@
+package contentful
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientOptions(t *testing.T) {
+	assert := assert.New(t)
+
+	httpClient := &http.Client{}
+	logger := log.New(&bytes.Buffer{}, "", 0)
+
+	cma := NewCMA(CMAToken,
+		WithBaseURL("http://localhost:8080"),
+		WithEnvironment("staging"),
+		WithHTTPClient(httpClient),
+		WithUserAgent("my-app/1.0"),
+		WithMaxRetries(2),
+		WithLogger(logger),
+	)
+
+	assert.Equal("http://localhost:8080", cma.BaseURL)
+	assert.Equal("staging", cma.Environment)
+	assert.Equal(httpClient, cma.client)
+	assert.Equal("my-app/1.0", cma.Headers["User-Agent"])
+	assert.Equal(3, cma.retryPolicy.(*DefaultRetryPolicy).MaxAttempts)
+	assert.Equal(logger, cma.logger)
+	assert.Equal(cma, cma.Entries.c)
+
+	// without options the defaults are kept
+	cma = NewCMA(CMAToken)
+	assert.Equal("https://api.contentful.com", cma.BaseURL)
+	assert.Equal("master", cma.Environment)
+	assert.Equal(http.DefaultClient, cma.client)
+	assert.Equal(5, cma.retryPolicy.(*DefaultRetryPolicy).MaxAttempts)
+	assert.Nil(cma.logger)
+
+	cda := NewCDA(CDAToken, WithBaseURL("http://localhost:8081"))
+	assert.Equal("http://localhost:8081", cda.BaseURL)
+
+	cpa := NewCPA(CPAToken, WithEnvironment("staging"))
+	assert.Equal("staging", cpa.Environment)
+}
+
+func TestClientOptionsHosts(t *testing.T) {
+	assert := assert.New(t)
+
+	cma := NewCMA(CMAToken)
+	assert.Equal("https://api.contentful.com", cma.BaseURL)
+	assert.Equal("https://upload.contentful.com", cma.UploadURL)
+	assert.Equal("https://cdn.contentful.com", cma.DeliveryURL)
+	assert.Equal("https://preview.contentful.com", cma.PreviewURL)
+
+	cma = NewCMA(CMAToken,
+		WithBaseURL("https://api.eu.contentful.com"),
+		WithUploadURL("https://upload.eu.contentful.com"),
+	)
+	assert.Equal("https://api.eu.contentful.com", cma.BaseURL)
+	assert.Equal("https://upload.eu.contentful.com", cma.UploadURL)
+
+	// the delivery and preview clients default to their hosts
+	cda := NewCDA(CDAToken, WithDeliveryURL("https://cdn.eu.contentful.com"))
+	assert.Equal("https://cdn.eu.contentful.com", cda.BaseURL)
+
+	cda = NewCDA(CDAToken, WithDeliveryURL("https://cdn.eu.contentful.com"), WithBaseURL("http://localhost:8081"))
+	assert.Equal("http://localhost:8081", cda.BaseURL)
+
+	cpa := NewCPA(CPAToken, WithPreviewURL("https://preview.eu.contentful.com"))
+	assert.Equal("https://preview.eu.contentful.com", cpa.BaseURL)
+}
+
+func TestClientOptionsRequests(t *testing.T) {
+	assert := assert.New(t)
+
+	requests := 0
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Equal("my-app/1.0", r.Header.Get("User-Agent"))
+		assert.Equal("/spaces/"+spaceID+"/environments/staging/entries", r.URL.Path)
+
+		w.WriteHeader(503)
+		fmt.Fprintln(w, `{"sys": {"type": "Error", "id": "ServerError"}, "message": "unavailable"}`)
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	var output bytes.Buffer
+
+	cma := NewCMA(CMAToken,
+		WithBaseURL(server.URL),
+		WithEnvironment("staging"),
+		WithUserAgent("my-app/1.0"),
+		WithMaxRetries(0),
+		WithLogger(log.New(&output, "", 0)),
+	)
+	cma.Debug = true
+
+	_, err := cma.Entries.List(spaceID).Next()
+	assert.NotNil(err)
+	assert.Equal(1, requests)
+	assert.Contains(output.String(), "unavailable")
+}
@@ -0,0 +1,104 @@
+package contentful
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// apiPath is the path of a request along with its template, the path with
+// the ids replaced by {id}. Requests are traced and measured by the template,
+// so that ids don't blow up the cardinality of metric labels.
+type apiPath struct {
+	path     string
+	template string
+
+	// spaceID and relative, the path relative to the environment, are set
+	// for environment scoped paths, see inEnvironment
+	spaceID  string
+	relative string
+	scoped   bool
+}
+
+// pathf formats the path like fmt.Sprintf, every verb stands for an id
+func pathf(format string, ids ...interface{}) apiPath {
+	return apiPath{
+		path:     fmt.Sprintf(format, ids...),
+		template: strings.ReplaceAll(format, "%s", "{id}"),
+	}
+}
+
+// spacePath returns the path of a space level resource, which is shared by
+// all environments of the space, e.g. webhooks or api keys. The format is
+// relative to the space, e.g. spacePath(spaceID, "/webhook_definitions/%s", id).
+func spacePath(spaceID, format string, ids ...interface{}) apiPath {
+	return pathf("/spaces/%s"+format, append([]interface{}{spaceID}, ids...)...)
+}
+
+// envPath returns the path of a resource scoped to the client's environment,
+// e.g. entries or content types. The format is relative to the environment,
+// e.g. c.envPath(spaceID, "/entries/%s", id).
+func (c *Client) envPath(spaceID, format string, ids ...interface{}) apiPath {
+	path := pathf("/spaces/%s/environments/%s"+format, append([]interface{}{spaceID, c.environment()}, ids...)...)
+	path.spaceID = spaceID
+	path.relative = fmt.Sprintf(format, ids...)
+	path.scoped = true
+
+	return path
+}
+
+// rawPath returns the apiPath of a path given as is, e.g. to Client.Do. Paths
+// below /spaces/<id>/environments/<id> are environment scoped.
+func rawPath(path string) apiPath {
+	p := apiPath{path: path, template: path}
+
+	segments := strings.SplitN(path, "/", 6)
+	if len(segments) >= 5 && segments[0] == "" && segments[1] == "spaces" && segments[3] == "environments" {
+		p.spaceID = segments[2]
+		p.scoped = true
+		if len(segments) == 6 {
+			p.relative = "/" + segments[5]
+		}
+	}
+
+	return p
+}
+
+// inEnvironment returns the path scoped to the given environment, paths which
+// are not environment scoped are returned as they are
+func (p apiPath) inEnvironment(environment string) apiPath {
+	if !p.scoped {
+		return p
+	}
+
+	p.path = fmt.Sprintf("/spaces/%s/environments/%s", p.spaceID, environment) + p.relative
+
+	return p
+}
+
+type apiPathKey struct{}
+
+// withContext returns a copy of req with its context changed to ctx, keeping
+// the apiPath of the request. The overrides stored in ctx are applied, see
+// WithRequestEnvironment and WithRequestHeader.
+func withContext(req *http.Request, ctx context.Context) *http.Request {
+	if path, ok := req.Context().Value(apiPathKey{}).(apiPath); ok {
+		ctx = context.WithValue(ctx, apiPathKey{}, path)
+	}
+
+	req = req.WithContext(ctx)
+	applyRequestOverrides(req)
+
+	return req
+}
+
+// requestPathTemplate returns the path template of the request, its path when
+// it has none
+func requestPathTemplate(req *http.Request) string {
+	if path, ok := req.Context().Value(apiPathKey{}).(apiPath); ok {
+		return path.template
+	}
+
+	return req.URL.Path
+}
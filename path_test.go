@@ -0,0 +1,180 @@
+package contentful
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPathf(t *testing.T) {
+	assert := assert.New(t)
+
+	path := pathf("/spaces/%s/entries/%s/published", "space", "entry")
+	assert.Equal("/spaces/space/entries/entry/published", path.path)
+	assert.Equal("/spaces/{id}/entries/{id}/published", path.template)
+
+	path = pathf("/spaces")
+	assert.Equal("/spaces", path.path)
+	assert.Equal("/spaces", path.template)
+}
+
+func TestPathTemplates(t *testing.T) {
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, `{"sys": {"id": "id", "version": 1}}`)
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	metrics := &fakeMetrics{}
+	cma := NewCMA(CMAToken,
+		WithBaseURL(server.URL),
+		WithUploadURL(server.URL),
+		WithMetrics(metrics),
+		WithRetryPolicy(nil),
+	)
+
+	sys := func() *Sys { return &Sys{ID: "id", CreatedAt: "2018-01-01T00:00:00Z", Version: 1} }
+
+	tests := []struct {
+		call     func()
+		method   string
+		template string
+	}{
+		{func() { cma.Spaces.List().Next() }, "GET", "/spaces"},
+		{func() { cma.Spaces.Get(spaceID) }, "GET", "/spaces/{id}"},
+		{func() { cma.APIKeys.List(spaceID).Next() }, "GET", "/spaces/{id}/api_keys"},
+		{func() { cma.APIKeys.Get(spaceID, "id") }, "GET", "/spaces/{id}/api_keys/{id}"},
+		{func() { cma.Assets.List(spaceID).Next() }, "GET", "/spaces/{id}/environments/{id}/assets"},
+		{func() { cma.Assets.Get(spaceID, "id") }, "GET", "/spaces/{id}/environments/{id}/assets/{id}"},
+		{func() { cma.Assets.Process(spaceID, &Asset{locale: "en-US", Sys: sys()}) }, "PUT", "/spaces/{id}/environments/{id}/assets/{id}/files/{id}/process"},
+		{func() { cma.Assets.Publish(spaceID, &Asset{Sys: sys()}) }, "PUT", "/spaces/{id}/environments/{id}/assets/{id}/published"},
+		{func() { cma.ContentTypes.List(spaceID).Next() }, "GET", "/spaces/{id}/environments/{id}/content_types"},
+		{func() { cma.ContentTypes.Get(spaceID, "id") }, "GET", "/spaces/{id}/environments/{id}/content_types/{id}"},
+		{func() { cma.ContentTypes.Activate(spaceID, &ContentType{Sys: sys()}) }, "PUT", "/spaces/{id}/environments/{id}/content_types/{id}/published"},
+		{func() { cma.ContentTypes.ListSnapshots(spaceID, "id").Next() }, "GET", "/spaces/{id}/environments/{id}/content_types/{id}/snapshots"},
+		{func() { cma.ContentTypes.GetSnapshot(spaceID, "id", "id") }, "GET", "/spaces/{id}/environments/{id}/content_types/{id}/snapshots/{id}"},
+		{func() { cma.Entries.List(spaceID).Next() }, "GET", "/spaces/{id}/environments/{id}/entries"},
+		{func() { cma.Entries.Get(spaceID, "id") }, "GET", "/spaces/{id}/environments/{id}/entries/{id}"},
+		{func() { cma.Entries.ForceDelete(context.Background(), spaceID, &Entry{Sys: sys()}) }, "DELETE", "/spaces/{id}/environments/{id}/entries/{id}"},
+		{func() { cma.Entries.Publish(spaceID, &Entry{Sys: sys()}) }, "PUT", "/spaces/{id}/environments/{id}/entries/{id}/published"},
+		{func() { cma.Entries.ListSnapshots(spaceID, "id").Next() }, "GET", "/spaces/{id}/environments/{id}/entries/{id}/snapshots"},
+		{func() { cma.Entries.GetSnapshot(spaceID, "id", "id") }, "GET", "/spaces/{id}/environments/{id}/entries/{id}/snapshots/{id}"},
+		{func() { cma.Locales.List(spaceID).Next() }, "GET", "/spaces/{id}/environments/{id}/locales"},
+		{func() { cma.Locales.Get(spaceID, "id") }, "GET", "/spaces/{id}/environments/{id}/locales/{id}"},
+		{func() { cma.Webhooks.List(spaceID).Next() }, "GET", "/spaces/{id}/webhook_definitions"},
+		{func() { cma.Webhooks.Get(spaceID, "id") }, "GET", "/spaces/{id}/webhook_definitions/{id}"},
+		{func() { cma.Sync.Initial(context.Background(), spaceID, SyncTypeAll) }, "GET", "/spaces/{id}/environments/{id}/sync"},
+		{func() { cma.EnvironmentAliases.List(spaceID).Next() }, "GET", "/spaces/{id}/environment_aliases"},
+		{func() { cma.EnvironmentAliases.Get(context.Background(), spaceID, "id") }, "GET", "/spaces/{id}/environment_aliases/{id}"},
+		{func() { cma.PersonalAccessTokens.List(context.Background()).Next() }, "GET", "/users/me/access_tokens"},
+		{func() { cma.PersonalAccessTokens.Revoke(context.Background(), "id") }, "PUT", "/users/me/access_tokens/{id}/revoked"},
+		{func() { cma.UIExtensions.List(spaceID).Next() }, "GET", "/spaces/{id}/environments/{id}/extensions"},
+		{func() { cma.UIExtensions.Get(spaceID, "id") }, "GET", "/spaces/{id}/environments/{id}/extensions/{id}"},
+		{func() { cma.AppDefinitions.List("org").Next() }, "GET", "/organizations/{id}/app_definitions"},
+		{func() { cma.AppDefinitions.Get("org", "id") }, "GET", "/organizations/{id}/app_definitions/{id}"},
+		{func() { cma.AppInstallations.List(spaceID).Next() }, "GET", "/spaces/{id}/environments/{id}/app_installations"},
+		{func() { cma.AppInstallations.Get(context.Background(), spaceID, "id") }, "GET", "/spaces/{id}/environments/{id}/app_installations/{id}"},
+		{func() { cma.Usage.Spaces(context.Background(), "org") }, "GET", "/organizations/{id}/space_periodic_usages"},
+		{func() { cma.Usage.APIRequests(context.Background(), "org", time.Now(), time.Now()) }, "GET", "/organizations/{id}/organization_periodic_usages"},
+		{func() { cma.Comments.List(spaceID, "id").Next() }, "GET", "/spaces/{id}/environments/{id}/entries/{id}/comments"},
+		{func() { cma.Comments.Get(spaceID, "id", "id") }, "GET", "/spaces/{id}/environments/{id}/entries/{id}/comments/{id}"},
+		{func() { cma.Tasks.List(spaceID, "id").Next() }, "GET", "/spaces/{id}/environments/{id}/entries/{id}/tasks"},
+		{func() { cma.Tasks.Get(spaceID, "id", "id") }, "GET", "/spaces/{id}/environments/{id}/entries/{id}/tasks/{id}"},
+		{func() { cma.Releases.List(spaceID).Next() }, "GET", "/spaces/{id}/environments/{id}/releases"},
+		{func() { cma.Releases.GetAction(spaceID, "id", "id") }, "GET", "/spaces/{id}/environments/{id}/releases/{id}/actions/{id}"},
+		{func() { cma.Uploads.Get(spaceID, "id") }, "GET", "/spaces/{id}/environments/{id}/uploads/{id}"},
+		{func() { cma.Export.getEditorInterface(context.Background(), spaceID, "id") }, "GET", "/spaces/{id}/environments/{id}/content_types/{id}/editor_interface"},
+	}
+
+	for _, test := range tests {
+		metrics.observations = nil
+		test.call()
+
+		if assert.NotEmpty(metrics.observations, test.template) {
+			observed := metrics.observations[0]
+			assert.Equal(test.method, observed.method, test.template)
+			assert.Equal(test.template, observed.pathTemplate)
+		}
+	}
+}
+
+func TestSpaceLevelPaths(t *testing.T) {
+	assert := assert.New(t)
+
+	paths := []string{}
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		fmt.Fprintln(w, `{"sys": {"id": "id", "version": 1}}`)
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	cma := NewCMA(CMAToken, WithBaseURL(server.URL), WithEnvironment("staging"), WithRetryPolicy(nil))
+
+	cma.Webhooks.List(spaceID).Next()
+	cma.Webhooks.Get(spaceID, "id")
+	cma.Webhooks.Upsert(spaceID, &Webhook{Name: "deploy"})
+	cma.Webhooks.Delete(spaceID, &Webhook{Sys: &Sys{ID: "id"}})
+	cma.Webhooks.SetSigningSecret(spaceID, "secret")
+	cma.APIKeys.List(spaceID).Next()
+	cma.EnvironmentAliases.List(spaceID).Next()
+
+	// space level resources are shared by the environments
+	assert.Equal(7, len(paths))
+	for _, path := range paths {
+		assert.NotContains(path, "/environments/")
+	}
+
+	// environment scoped resources are not
+	paths = nil
+	cma.Entries.Get(spaceID, "id")
+	cma.Assets.Get(spaceID, "id")
+	cma.Locales.List(spaceID).Next()
+	assert.Equal([]string{
+		"/spaces/" + spaceID + "/environments/staging/entries/id",
+		"/spaces/" + spaceID + "/environments/staging/assets/id",
+		"/spaces/" + spaceID + "/environments/staging/locales",
+	}, paths)
+}
+
+func TestDefaultEnvironmentPaths(t *testing.T) {
+	assert := assert.New(t)
+
+	paths := []string{}
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		fmt.Fprintln(w, `{"sys": {"id": "id", "version": 1}}`)
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// the preview client has no environment set
+	cpa := NewCPA(CPAToken, WithBaseURL(server.URL), WithRetryPolicy(nil))
+	assert.Equal("", cpa.Environment)
+
+	cma := NewCMA(CMAToken, WithBaseURL(server.URL), WithRetryPolicy(nil))
+	cma.Environment = ""
+
+	cpa.Entries.List(spaceID).Next()
+	cma.Entries.Get(spaceID, "id")
+	cma.Assets.Get(spaceID, "id")
+	cma.ContentTypes.Get(spaceID, "id")
+	cma.Locales.List(spaceID).Next()
+	cma.Sync.Initial(context.Background(), spaceID, SyncTypeAll)
+	cma.WithEnvironment("").Entries.Get(spaceID, "id")
+
+	assert.Equal(7, len(paths))
+	for _, path := range paths {
+		assert.Contains(path, "/spaces/"+spaceID+"/environments/master/")
+	}
+}
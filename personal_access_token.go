@@ -0,0 +1,106 @@
+package contentful
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+)
+
+// PersonalAccessTokensService service
+type PersonalAccessTokensService service
+
+// PersonalAccessToken model
+type PersonalAccessToken struct {
+	Sys       *Sys     `json:"sys,omitempty"`
+	Name      string   `json:"name,omitempty"`
+	Scopes    []string `json:"scopes,omitempty"`
+	RevokedAt string   `json:"revokedAt,omitempty"`
+
+	// Token is the secret of the token. It is only returned when the token is
+	// created, store it then as it can not be read later on.
+	Token string `json:"token,omitempty"`
+}
+
+const (
+	// ScopeManage personal access token scope for read and write access
+	ScopeManage = "content_management_manage"
+
+	// ScopeRead personal access token scope for read only access
+	ScopeRead = "content_management_read"
+)
+
+// List returns a personal access tokens collection
+func (service *PersonalAccessTokensService) List(ctx context.Context) *Collection {
+	path := pathf("/users/me/access_tokens")
+	method := "GET"
+
+	req, err := service.c.newRequest(method, path, nil, nil)
+	if err != nil {
+		return &Collection{}
+	}
+
+	col := NewCollection(&CollectionOptions{})
+	col.c = service.c
+	col.req = req
+
+	return col.WithContext(ctx)
+}
+
+// Get returns a single personal access token, without its secret
+func (service *PersonalAccessTokensService) Get(ctx context.Context, tokenID string) (*PersonalAccessToken, error) {
+	path := pathf("/users/me/access_tokens/%s", tokenID)
+	method := "GET"
+
+	req, err := service.c.newRequest(method, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var token PersonalAccessToken
+	if err := service.c.do(withContext(req, ctx), &token); err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+// Create creates a personal access token with the given scopes, e.g.
+// ScopeManage. The returned token holds the secret in `Token`, which is not
+// returned by any other call.
+func (service *PersonalAccessTokensService) Create(ctx context.Context, name string, scopes []string) (*PersonalAccessToken, error) {
+	bytesArray, err := json.Marshal(&PersonalAccessToken{
+		Name:   name,
+		Scopes: scopes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	path := pathf("/users/me/access_tokens")
+	method := "POST"
+
+	req, err := service.c.newRequest(method, path, nil, bytes.NewReader(bytesArray))
+	if err != nil {
+		return nil, err
+	}
+
+	var token PersonalAccessToken
+	if err := service.c.do(withContext(req, ctx), &token); err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+// Revoke revokes the personal access token, it can not be used afterwards
+func (service *PersonalAccessTokensService) Revoke(ctx context.Context, tokenID string) error {
+	path := pathf("/users/me/access_tokens/%s/revoked", tokenID)
+	method := "PUT"
+
+	req, err := service.c.newRequest(method, path, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return service.c.do(withContext(req, ctx), nil)
+}
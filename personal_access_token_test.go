@@ -0,0 +1,108 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const personalAccessTokenJSON = `{
+	"sys": {"type": "PersonalAccessToken", "id": "token-id", "createdAt": "2019-07-01T10:00:00Z"},
+	"name": "ci",
+	"scopes": ["content_management_manage"],
+	"revokedAt": null%s
+}`
+
+func TestPersonalAccessTokensServiceList(t *testing.T) {
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("GET", r.Method)
+		assert.Equal("/users/me/access_tokens", r.URL.Path)
+		checkHeaders(r, assert)
+
+		w.WriteHeader(200)
+		fmt.Fprintf(w, `{"sys": {"type": "Array"}, "total": 1, "items": [`+personalAccessTokenJSON+`]}`, "")
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	col, err := cma.PersonalAccessTokens.List(context.Background()).Next()
+	assert.Nil(err)
+
+	tokens := col.ToPersonalAccessToken()
+	assert.Equal(1, len(tokens))
+	assert.Equal("ci", tokens[0].Name)
+	assert.Equal("", tokens[0].Token)
+}
+
+func TestPersonalAccessTokensServiceCreate(t *testing.T) {
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("POST", r.Method)
+		assert.Equal("/users/me/access_tokens", r.URL.Path)
+		checkHeaders(r, assert)
+
+		var payload map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assert.Nil(err)
+		assert.Equal("ci", payload["name"])
+		assert.Equal([]interface{}{"content_management_manage"}, payload["scopes"])
+
+		w.WriteHeader(201)
+		fmt.Fprintf(w, personalAccessTokenJSON, `, "token": "CFPAT-secret"`)
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	token, err := cma.PersonalAccessTokens.Create(context.Background(), "ci", []string{ScopeManage})
+	assert.Nil(err)
+	assert.Equal("token-id", token.Sys.ID)
+	assert.Equal("CFPAT-secret", token.Token)
+}
+
+func TestPersonalAccessTokensServiceRevoke(t *testing.T) {
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("PUT", r.Method)
+		assert.Equal("/users/me/access_tokens/token-id/revoked", r.URL.Path)
+		checkHeaders(r, assert)
+
+		w.WriteHeader(200)
+		fmt.Fprintf(w, personalAccessTokenJSON, "")
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	assert.Nil(cma.PersonalAccessTokens.Revoke(context.Background(), "token-id"))
+
+	// the request is made with the given context
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.NotNil(cma.PersonalAccessTokens.Revoke(ctx, "token-id"))
+}
@@ -1,6 +1,7 @@
 package contentful
 
 import (
+	"fmt"
 	"net/url"
 	"reflect"
 	"strconv"
@@ -8,9 +9,16 @@ import (
 	"time"
 )
 
+// maxLimit is the largest number of items the api returns per page
+const maxLimit = 1000
+
+// maxInclude is the deepest level of linked entities the api includes
+const maxInclude = 10
+
 // Query model
 type Query struct {
 	include     uint16
+	includeErr  error
 	contentType string
 	fields      []string
 	e           map[string]interface{}
@@ -30,6 +38,7 @@ type Query struct {
 	within      map[string]string
 	order       []string
 	limit       uint16
+	limitErr    error
 	skip        uint16
 	mime        string
 	locale      string
@@ -64,9 +73,19 @@ func NewQuery() *Query {
 	}
 }
 
-// Include query
+// Include query, the depth of linked entities included along with the items.
+// The api includes 1 level unless set, and at most 10 levels, deeper levels
+// are not set and reported by Err instead, and by the collection's requests.
+// Include(0) leaves the default, see Collection.ResolveLinks to not include
+// linked entities.
 func (q *Query) Include(include uint16) *Query {
+	if include > maxInclude {
+		q.includeErr = fmt.Errorf("include %d is out of range, the api includes at most %d levels of links", include, maxInclude)
+		return q
+	}
+
 	q.include = include
+	q.includeErr = nil
 	return q
 }
 
@@ -112,6 +131,26 @@ func (q *Query) NotIn(field string, value []string) *Query {
 	return q
 }
 
+// WithTags filters for entities tagged with any of the given tag ids
+func (q *Query) WithTags(ids ...string) *Query {
+	return q.In("metadata.tags.sys.id", ids)
+}
+
+// WithoutTags filters for entities tagged with none of the given tag ids
+func (q *Query) WithoutTags(ids ...string) *Query {
+	return q.NotIn("metadata.tags.sys.id", ids)
+}
+
+// LinksToEntry filters for entries linking to the entry with the given id
+func (q *Query) LinksToEntry(entryID string) *Query {
+	return q.Equal("links_to_entry", entryID)
+}
+
+// LinksToAsset filters for entries linking to the asset with the given id
+func (q *Query) LinksToAsset(assetID string) *Query {
+	return q.Equal("links_to_asset", assetID)
+}
+
 // Exists [exists] query
 func (q *Query) Exists(field string) *Query {
 	q.exists = append(q.exists, field)
@@ -160,24 +199,50 @@ func (q *Query) Match(field, match string) *Query {
 	return q
 }
 
-// Near param
-func (q *Query) Near(field string, lat, lon int16) *Query {
-	q.near[field] = strconv.Itoa(int(lat)) + "," + strconv.Itoa(int(lon))
+// Near param, orders by the distance of the location field to the given
+// coordinates, nearest first
+func (q *Query) Near(field string, lat, lon float64) *Query {
+	q.near[field] = formatCoordinates(lat, lon)
 	return q
 }
 
 // Within param
+//
+// Deprecated: use WithinBox, which takes fractional coordinates
 func (q *Query) Within(field string, lat1, lon1, lat2, lon2 int16) *Query {
-	q.within[field] = strconv.Itoa(int(lat1)) + "," + strconv.Itoa(int(lon1)) + "," + strconv.Itoa(int(lat2)) + "," + strconv.Itoa(int(lon2))
+	return q.WithinBox(field, float64(lat1), float64(lon1), float64(lat2), float64(lon2))
+}
+
+// WithinBox param, matches the location field within the rectangle of the
+// given bottom left and top right corners
+func (q *Query) WithinBox(field string, bottomLeftLat, bottomLeftLon, topRightLat, topRightLon float64) *Query {
+	q.within[field] = formatCoordinates(bottomLeftLat, bottomLeftLon, topRightLat, topRightLon)
 	return q
 }
 
 // WithinRadius param
+//
+// Deprecated: use WithinCircle, which takes fractional coordinates
 func (q *Query) WithinRadius(field string, lat1, lon1, radius int16) *Query {
-	q.within[field] = strconv.Itoa(int(lat1)) + "," + strconv.Itoa(int(lon1)) + "," + strconv.Itoa(int(radius))
+	return q.WithinCircle(field, float64(lat1), float64(lon1), float64(radius))
+}
+
+// WithinCircle param, matches the location field within the given radius in
+// kilometers around the given coordinates
+func (q *Query) WithinCircle(field string, lat, lon, radius float64) *Query {
+	q.within[field] = formatCoordinates(lat, lon, radius)
 	return q
 }
 
+func formatCoordinates(values ...float64) string {
+	formatted := make([]string, len(values))
+	for i, value := range values {
+		formatted[i] = strconv.FormatFloat(value, 'f', -1, 64)
+	}
+
+	return strings.Join(formatted, ",")
+}
+
 // Order param
 func (q *Query) Order(field string, reverse bool) *Query {
 	if reverse {
@@ -189,9 +254,16 @@ func (q *Query) Order(field string, reverse bool) *Query {
 	return q
 }
 
-// Limit query
+// Limit query. The api returns at most 1000 items per page, larger limits are
+// not set and reported by Err instead, and by the collection's requests.
 func (q *Query) Limit(limit uint16) *Query {
+	if limit > maxLimit {
+		q.limitErr = fmt.Errorf("limit %d is out of range, the api returns at most %d items per page", limit, maxLimit)
+		return q
+	}
+
 	q.limit = limit
+	q.limitErr = nil
 	return q
 }
 
@@ -218,10 +290,6 @@ func (q *Query) Values() url.Values {
 	params := url.Values{}
 
 	if q.include != 0 {
-		if q.include > 10 {
-			panic("include value should be between 0 and 10")
-		}
-
 		params.Set("include", strconv.Itoa(int(q.include)))
 	}
 
@@ -382,10 +450,6 @@ func (q *Query) Values() url.Values {
 	}
 
 	if q.limit != 0 {
-		if q.limit > 1000 {
-			panic("limit value should be between 0 and 1000")
-		}
-
 		params.Set("limit", strconv.Itoa(int(q.limit)))
 	}
 
@@ -404,6 +468,16 @@ func (q *Query) Values() url.Values {
 	return params
 }
 
+// Err returns the error of an invalid query option, e.g. an out of range
+// limit
+func (q *Query) Err() error {
+	if q.includeErr != nil {
+		return q.includeErr
+	}
+
+	return q.limitErr
+}
+
 func (q *Query) String() string {
 	return q.Values().Encode()
 }
@@ -15,10 +15,20 @@ func TestQueryInclude(t *testing.T) {
 	expected.Set("include", "5")
 	assert.Equal(t, expected.Encode(), q.String())
 
-	assert.Panics(t, func() {
-		q := NewQuery().Include(11)
-		q.String()
-	}, "out of range `include` should panic")
+	assert.Nil(t, q.Err())
+
+	// the api includes at most 10 levels
+	q = NewQuery().Include(10)
+	assert.Nil(t, q.Err())
+	assert.Equal(t, "include=10", q.String())
+
+	q.Include(11)
+	assert.NotNil(t, q.Err())
+	assert.Equal(t, "include=10", q.String())
+
+	q.Include(1)
+	assert.Nil(t, q.Err())
+	assert.Equal(t, "include=1", q.String())
 }
 
 func TestQueryContentType(t *testing.T) {
@@ -52,12 +62,12 @@ func TestQuerySelect(t *testing.T) {
 		}
 
 		q := NewQuery().Select(fields)
-		q.String()
+		_ = q.String()
 	}, "select accepts 100 fields max")
 
 	assert.Panics(t, func() {
 		q := NewQuery().Select([]string{"field1", "field2.d1", "field3.d2.d3"})
-		q.String()
+		_ = q.String()
 	}, "select accepts depths 3 max")
 }
 
@@ -214,6 +224,35 @@ func TestQueryWithinRadius(t *testing.T) {
 	assert.Equal(t, expected.Encode(), q.String())
 }
 
+func TestQueryGeo(t *testing.T) {
+	q := NewQuery().Near("fields.location", 51.5074, -0.1278)
+	expected := url.Values{}
+	expected.Set("fields.location[near]", "51.5074,-0.1278")
+	assert.Equal(t, expected.Encode(), q.String())
+
+	q = NewQuery().WithinBox("fields.location", 51.28, -0.489, 51.686, 0.236)
+	expected = url.Values{}
+	expected.Set("fields.location[within]", "51.28,-0.489,51.686,0.236")
+	assert.Equal(t, expected.Encode(), q.String())
+
+	q = NewQuery().WithinCircle("fields.location", 51.5074, -0.1278, 2.5)
+	expected = url.Values{}
+	expected.Set("fields.location[within]", "51.5074,-0.1278,2.5")
+	assert.Equal(t, expected.Encode(), q.String())
+}
+
+func TestQueryLinksTo(t *testing.T) {
+	q := NewQuery().LinksToEntry("nyancat")
+	expected := url.Values{}
+	expected.Set("links_to_entry", "nyancat")
+	assert.Equal(t, expected.Encode(), q.String())
+
+	q = NewQuery().LinksToAsset("happycat")
+	expected = url.Values{}
+	expected.Set("links_to_asset", "happycat")
+	assert.Equal(t, expected.Encode(), q.String())
+}
+
 func TestQueryOrder(t *testing.T) {
 	q := NewQuery().ContentType("ct").Order("field1", false)
 	expected := url.Values{}
@@ -250,10 +289,21 @@ func TestQueryLimit(t *testing.T) {
 	expected.Set("limit", "10")
 	assert.Equal(t, expected.Encode(), q.String())
 
-	assert.Panics(t, func() {
-		q := NewQuery().Limit(3000)
-		q.String()
-	}, "out of range limit should panic")
+	assert.Nil(t, q.Err())
+
+	// the api returns at most 1000 items per page
+	q = NewQuery().Limit(1000)
+	assert.Nil(t, q.Err())
+	assert.Equal(t, "limit=1000", q.String())
+
+	q.Limit(1001)
+	assert.NotNil(t, q.Err())
+	assert.Equal(t, "limit=1000", q.String())
+
+	// a valid limit replaces the out of range one
+	q.Limit(100)
+	assert.Nil(t, q.Err())
+	assert.Equal(t, "limit=100", q.String())
 }
 
 func TestQuerySkip(t *testing.T) {
@@ -270,6 +320,22 @@ func TestQueryMimeType(t *testing.T) {
 	assert.Equal(t, expected.Encode(), q.String())
 }
 
+func TestQueryTags(t *testing.T) {
+	q := NewQuery().
+		WithTags("news", "featured").
+		WithoutTags("archived")
+
+	expected := url.Values{}
+	expected.Set("metadata.tags.sys.id[in]", "news,featured")
+	expected.Set("metadata.tags.sys.id[nin]", "archived")
+
+	assert.Equal(t, expected.Encode(), q.String())
+
+	col := NewCollection(&CollectionOptions{})
+	col.WithTags("news")
+	assert.Equal(t, "metadata.tags.sys.id%5Bin%5D=news&order=-sys.createdAt", col.Query.String())
+}
+
 func TestQuery(t *testing.T) {
 	q := NewQuery().
 		Equal("cat.name", "catname").
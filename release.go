@@ -0,0 +1,269 @@
+package contentful
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+const (
+	// BulkActionStatusCreated is the status of actions not started yet
+	BulkActionStatusCreated = "created"
+
+	// BulkActionStatusInProgress is the status of running actions
+	BulkActionStatusInProgress = "inProgress"
+
+	// BulkActionStatusSucceeded is the status of actions done successfully
+	BulkActionStatusSucceeded = "succeeded"
+
+	// BulkActionStatusFailed is the status of failed actions, see Error
+	BulkActionStatusFailed = "failed"
+)
+
+// ReleasesService service
+type ReleasesService service
+
+// VersionedLink model, a link to a version of an entry or asset
+type VersionedLink struct {
+	Sys *Sys `json:"sys"`
+}
+
+// NewVersionedLink returns a link to the given version of the entity of the
+// given type and id, a version of 0 links to the latest version
+func NewVersionedLink(linkType, id string, version int) *VersionedLink {
+	return &VersionedLink{
+		Sys: &Sys{
+			Type:     "Link",
+			LinkType: linkType,
+			ID:       id,
+			Version:  version,
+		},
+	}
+}
+
+// Release model, entries and assets published together
+type Release struct {
+	Sys      *Sys
+	Title    string
+	Entities []*VersionedLink
+}
+
+type releasePayload struct {
+	Sys      *Sys   `json:"sys,omitempty"`
+	Title    string `json:"title"`
+	Entities struct {
+		Sys   *Sys             `json:"sys"`
+		Items []*VersionedLink `json:"items"`
+	} `json:"entities"`
+}
+
+// MarshalJSON for custom json marshaling
+func (release *Release) MarshalJSON() ([]byte, error) {
+	payload := releasePayload{
+		Sys:   release.Sys,
+		Title: release.Title,
+	}
+
+	payload.Entities.Sys = &Sys{Type: "Array"}
+	payload.Entities.Items = release.Entities
+	if payload.Entities.Items == nil {
+		payload.Entities.Items = []*VersionedLink{}
+	}
+
+	return json.Marshal(payload)
+}
+
+// UnmarshalJSON for custom json unmarshaling
+func (release *Release) UnmarshalJSON(data []byte) error {
+	var payload releasePayload
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return err
+	}
+
+	release.Sys = payload.Sys
+	release.Title = payload.Title
+	release.Entities = payload.Entities.Items
+
+	return nil
+}
+
+// GetVersion returns entity version
+func (release *Release) GetVersion() int {
+	version := 1
+	if release.Sys != nil {
+		version = release.Sys.Version
+	}
+
+	return version
+}
+
+// BulkAction model, an action run in the background, e.g. publishing a
+// release. Its progress is in Sys.Status, poll it until it is succeeded or
+// failed.
+type BulkAction struct {
+	Sys    *Sys           `json:"sys"`
+	Action string         `json:"action,omitempty"`
+	Error  *ErrorResponse `json:"error,omitempty"`
+}
+
+// Done reports whether the action succeeded or failed
+func (action *BulkAction) Done() bool {
+	return action.Sys != nil && (action.Sys.Status == BulkActionStatusSucceeded || action.Sys.Status == BulkActionStatusFailed)
+}
+
+// List returns the releases collection
+func (service *ReleasesService) List(spaceID string) *Collection {
+	path := service.c.envPath(spaceID, "/releases")
+	method := "GET"
+
+	req, err := service.c.newRequest(method, path, nil, nil)
+	if err != nil {
+		return &Collection{}
+	}
+
+	col := NewCollection(&CollectionOptions{})
+	col.c = service.c
+	col.req = req
+
+	return col
+}
+
+// Get returns a single release
+func (service *ReleasesService) Get(spaceID, releaseID string) (*Release, error) {
+	path := service.c.envPath(spaceID, "/releases/%s", releaseID)
+	method := "GET"
+
+	req, err := service.c.newRequest(method, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var release Release
+	if err := service.c.do(req, &release); err != nil {
+		return nil, err
+	}
+
+	return &release, nil
+}
+
+// Create creates a release of the given entries and assets
+func (service *ReleasesService) Create(ctx context.Context, spaceID string, title string, entities []*VersionedLink) (*Release, error) {
+	release := &Release{
+		Title:    title,
+		Entities: entities,
+	}
+
+	bytesArray, err := json.Marshal(release)
+	if err != nil {
+		return nil, err
+	}
+
+	path := service.c.envPath(spaceID, "/releases")
+	method := "POST"
+
+	req, err := service.c.newRequest(method, path, nil, bytes.NewReader(bytesArray))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := service.c.do(withContext(req, ctx), release); err != nil {
+		return nil, err
+	}
+
+	return release, nil
+}
+
+// Update replaces the title and the entities of the release
+func (service *ReleasesService) Update(spaceID string, release *Release) error {
+	if release.Sys == nil || release.Sys.ID == "" {
+		return fmt.Errorf("updating a release requires a release id")
+	}
+
+	bytesArray, err := json.Marshal(&Release{
+		Title:    release.Title,
+		Entities: release.Entities,
+	})
+	if err != nil {
+		return err
+	}
+
+	path := service.c.envPath(spaceID, "/releases/%s", release.Sys.ID)
+	method := "PUT"
+
+	req, err := service.c.newRequest(method, path, nil, bytes.NewReader(bytesArray))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("X-Contentful-Version", strconv.Itoa(release.GetVersion()))
+
+	return service.c.do(req, release)
+}
+
+// Delete the release, the entries and assets of the release are kept
+func (service *ReleasesService) Delete(spaceID string, release *Release) error {
+	path := service.c.envPath(spaceID, "/releases/%s", release.Sys.ID)
+	method := "DELETE"
+
+	req, err := service.c.newRequest(method, path, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return service.c.do(req, nil)
+}
+
+// Publish starts publishing the entries and assets of the release, the
+// current version of the release is fetched first. The publish runs in the
+// background, poll the returned action with GetAction until it is done.
+func (service *ReleasesService) Publish(ctx context.Context, spaceID, releaseID string) (*BulkAction, error) {
+	path := service.c.envPath(spaceID, "/releases/%s", releaseID)
+
+	req, err := service.c.newRequest(http.MethodGet, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var release Release
+	if err := service.c.do(withContext(req, ctx), &release); err != nil {
+		return nil, err
+	}
+
+	path = service.c.envPath(spaceID, "/releases/%s/published", releaseID)
+
+	req, err = service.c.newRequest(http.MethodPut, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("X-Contentful-Version", strconv.Itoa(release.GetVersion()))
+
+	var action BulkAction
+	if err := service.c.do(withContext(req, ctx), &action); err != nil {
+		return nil, err
+	}
+
+	return &action, nil
+}
+
+// GetAction returns an action of the release, e.g. to poll the action
+// returned by Publish
+func (service *ReleasesService) GetAction(spaceID, releaseID, actionID string) (*BulkAction, error) {
+	path := service.c.envPath(spaceID, "/releases/%s/actions/%s", releaseID, actionID)
+	method := "GET"
+
+	req, err := service.c.newRequest(method, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var action BulkAction
+	if err := service.c.do(req, &action); err != nil {
+		return nil, err
+	}
+
+	return &action, nil
+}
@@ -0,0 +1,96 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReleasesServiceCreate(t *testing.T) {
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("POST", r.Method)
+		assert.Equal("/spaces/"+spaceID+"/environments/master/releases", r.URL.Path)
+
+		var payload map[string]interface{}
+		assert.Nil(json.NewDecoder(r.Body).Decode(&payload))
+		assert.Equal("Spring launch", payload["title"])
+		assert.Nil(payload["sys"])
+
+		entities := payload["entities"].(map[string]interface{})
+		assert.Equal(map[string]interface{}{"type": "Array"}, entities["sys"])
+		assert.Equal([]interface{}{
+			map[string]interface{}{"sys": map[string]interface{}{"type": "Link", "linkType": "Entry", "id": "nyancat", "version": float64(3)}},
+			map[string]interface{}{"sys": map[string]interface{}{"type": "Link", "linkType": "Asset", "id": "happycat"}},
+		}, entities["items"])
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintln(w, `{
+			"sys": {"id": "release-1", "type": "Release", "version": 1},
+			"title": "Spring launch",
+			"entities": {"sys": {"type": "Array"}, "items": [
+				{"sys": {"type": "Link", "linkType": "Entry", "id": "nyancat", "version": 3}},
+				{"sys": {"type": "Link", "linkType": "Asset", "id": "happycat"}}
+			]}
+		}`)
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	cma = NewCMA(CMAToken, WithBaseURL(server.URL))
+
+	release, err := cma.Releases.Create(context.Background(), spaceID, "Spring launch", []*VersionedLink{
+		NewVersionedLink(LinkTypeEntry, "nyancat", 3),
+		NewVersionedLink(LinkTypeAsset, "happycat", 0),
+	})
+	assert.Nil(err)
+	assert.Equal("release-1", release.Sys.ID)
+	assert.Equal(1, release.GetVersion())
+	assert.Equal(2, len(release.Entities))
+	assert.Equal("happycat", release.Entities[1].Sys.ID)
+}
+
+func TestReleasesServicePublish(t *testing.T) {
+	assert := assert.New(t)
+
+	releasePath := "/spaces/" + spaceID + "/environments/master/releases/release-1"
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == releasePath:
+			fmt.Fprintln(w, `{"sys": {"id": "release-1", "version": 4}, "title": "Spring launch", "entities": {"items": []}}`)
+		case r.Method == "PUT" && r.URL.Path == releasePath+"/published":
+			assert.Equal("4", r.Header.Get("X-Contentful-Version"))
+			w.WriteHeader(http.StatusAccepted)
+			fmt.Fprintln(w, `{"sys": {"id": "action-1", "type": "ReleaseAction", "status": "inProgress"}, "action": "publish"}`)
+		case r.Method == "GET" && r.URL.Path == releasePath+"/actions/action-1":
+			fmt.Fprintln(w, `{"sys": {"id": "action-1", "type": "ReleaseAction", "status": "succeeded"}, "action": "publish"}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	cma = NewCMA(CMAToken, WithBaseURL(server.URL))
+
+	action, err := cma.Releases.Publish(context.Background(), spaceID, "release-1")
+	assert.Nil(err)
+	assert.Equal("publish", action.Action)
+	assert.Equal(BulkActionStatusInProgress, action.Sys.Status)
+	assert.False(action.Done())
+
+	action, err = cma.Releases.GetAction(spaceID, "release-1", action.Sys.ID)
+	assert.Nil(err)
+	assert.Equal(BulkActionStatusSucceeded, action.Sys.Status)
+	assert.True(action.Done())
+}
@@ -0,0 +1,62 @@
+package contentful
+
+import (
+	"context"
+	"net/http"
+)
+
+type requestContextKey int
+
+const (
+	requestEnvironmentKey requestContextKey = iota
+	requestHeadersKey
+)
+
+// WithRequestEnvironment returns a copy of ctx which scopes the requests made
+// with it to the given environment. It takes precedence over the environment
+// of the client. Only calls given the context honor it, i.e. the methods
+// taking a context, their Context variants and collections given the context
+// with Collection.WithContext, e.g.
+//
+//	ctx := contentful.WithRequestEnvironment(context.Background(), "staging")
+//	result, err := cda.Sync.Initial(ctx, spaceID, contentful.SyncTypeAll)
+func WithRequestEnvironment(ctx context.Context, environment string) context.Context {
+	return context.WithValue(ctx, requestEnvironmentKey, environment)
+}
+
+// WithRequestHeader returns a copy of ctx which sets the given header on the
+// requests made with it. It takes precedence over the headers of the client.
+func WithRequestHeader(ctx context.Context, key, value string) context.Context {
+	headers := http.Header{}
+	if parent, ok := ctx.Value(requestHeadersKey).(http.Header); ok {
+		headers = parent.Clone()
+	}
+
+	headers.Set(key, value)
+
+	return context.WithValue(ctx, requestHeadersKey, headers)
+}
+
+// applyRequestOverrides applies the environment and headers stored in the
+// request's context to the request. The environment replaces the one of
+// environment scoped paths only, the path is rebuilt from the request's
+// apiPath.
+func applyRequestOverrides(req *http.Request) {
+	ctx := req.Context()
+
+	if environment, ok := ctx.Value(requestEnvironmentKey).(string); ok && environment != "" {
+		if path, ok := ctx.Value(apiPathKey{}).(apiPath); ok && path.scoped {
+			u := *req.URL
+			u.Path = path.inEnvironment(environment).path
+			u.RawPath = ""
+			req.URL = &u
+		}
+	}
+
+	if headers, ok := ctx.Value(requestHeadersKey).(http.Header); ok {
+		req.Header = req.Header.Clone()
+		for key, values := range headers {
+			req.Header[key] = values
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package contentful
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestContextOverrides(t *testing.T) {
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("/spaces/"+spaceID+"/environments/staging/sync", r.URL.Path)
+		assert.Equal("request-42", r.Header.Get("X-Request-Id"))
+		assert.Equal("app my-app/1.0", r.Header.Get("X-Contentful-User-Agent"))
+		assert.Equal("Bearer "+CDAToken, r.Header.Get("Authorization"))
+
+		w.WriteHeader(200)
+		fmt.Fprintln(w, readTestData("sync-page2.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cda client
+	cda := NewCDA(CDAToken)
+	cda.BaseURL = server.URL
+	cda.SetEnvironment("production")
+
+	ctx := WithRequestEnvironment(context.Background(), "staging")
+	ctx = WithRequestHeader(ctx, "X-Request-Id", "request-42")
+	ctx = WithRequestHeader(ctx, "X-Contentful-User-Agent", "app my-app/1.0")
+
+	_, err := cda.Sync.Continue(ctx, spaceID, "synctoken")
+	assert.Nil(err)
+
+	// the client itself stays scoped to its environment
+	assert.Equal("production", cda.environment())
+}
+
+func TestRequestContextOverridesPaths(t *testing.T) {
+	assert := assert.New(t)
+
+	cma = NewCMA(CMAToken)
+	ctx := WithRequestEnvironment(context.Background(), "staging")
+
+	// paths which are not environment scoped are left untouched
+	req, err := cma.newRequest("GET", spacePath(spaceID, "/environment_aliases/%s", "environments"), nil, nil)
+	assert.Nil(err)
+	req = withContext(req, ctx)
+	assert.Equal("/spaces/"+spaceID+"/environment_aliases/environments", req.URL.Path)
+
+	// the environment of scoped paths is replaced, whatever their ids are
+	req, err = cma.newRequest("GET", cma.envPath("environments", "/entries/%s", "environments"), nil, nil)
+	assert.Nil(err)
+	req = withContext(req, ctx)
+	assert.Equal("/spaces/environments/environments/staging/entries/environments", req.URL.Path)
+	assert.Equal("/spaces/{id}/environments/{id}/entries/{id}", requestPathTemplate(req))
+}
+
+func TestRequestContextOverridesServices(t *testing.T) {
+	assert := assert.New(t)
+
+	paths := []string{}
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("request-42", r.Header.Get("X-Request-Id"))
+		paths = append(paths, r.Method+" "+r.URL.Path)
+		fmt.Fprintln(w, `{"sys": {"id": "id", "version": 1}}`)
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	cma = NewCMA(CMAToken, WithBaseURL(server.URL))
+
+	ctx := WithRequestEnvironment(context.Background(), "staging")
+	ctx = WithRequestHeader(ctx, "X-Request-Id", "request-42")
+
+	_, err := cma.Entries.GetContext(ctx, spaceID, "id")
+	assert.Nil(err)
+	assert.Nil(cma.Entries.UpsertContext(ctx, spaceID, &Entry{Sys: &Sys{ContentType: &ContentType{Sys: &Sys{ID: "cat"}}}}))
+	assert.Nil(cma.Entries.PublishContext(ctx, spaceID, &Entry{Sys: &Sys{ID: "id", Version: 1}}))
+	assert.Nil(cma.Entries.DeleteContext(ctx, spaceID, "id"))
+	_, err = cma.Assets.GetContext(ctx, spaceID, "id")
+	assert.Nil(err)
+	_, err = cma.ContentTypes.GetContext(ctx, spaceID, "id")
+	assert.Nil(err)
+	_, err = cma.Entries.List(spaceID).WithContext(ctx).Next()
+	assert.Nil(err)
+
+	base := "/spaces/" + spaceID + "/environments/staging"
+	assert.Equal([]string{
+		"GET " + base + "/entries/id",
+		"POST " + base + "/entries",
+		"PUT " + base + "/entries/id/published",
+		"DELETE " + base + "/entries/id",
+		"GET " + base + "/assets/id",
+		"GET " + base + "/content_types/id",
+		"GET " + base + "/entries",
+	}, paths)
+
+	// the client itself stays scoped to its environment
+	paths = nil
+	_, err = cma.Entries.GetContext(WithRequestHeader(context.Background(), "X-Request-Id", "request-42"), spaceID, "id")
+	assert.Nil(err)
+	assert.Equal([]string{"GET /spaces/" + spaceID + "/environments/master/entries/id"}, paths)
+}
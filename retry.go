@@ -0,0 +1,130 @@
+package contentful
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RetryPolicy decides whether a failed request is retried and how long to
+// wait before retrying it. `attempt` is the number of attempts made so far,
+// `resp` is nil when the request failed with `err`.
+type RetryPolicy interface {
+	ShouldRetry(attempt int, resp *http.Response, err error) (bool, time.Duration)
+}
+
+// DefaultRetryPolicy retries rate limited requests after the delay the api
+// asks for. Server errors and failed connections are retried with an
+// exponential backoff with jitter for idempotent requests only, i.e. GET,
+// HEAD, PUT and DELETE, so that e.g. a create whose response got lost is not
+// sent twice.
+type DefaultRetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts made for a request
+	MaxAttempts int
+
+	// BaseDelay is the shortest wait between attempts
+	BaseDelay time.Duration
+
+	// MaxDelay caps the wait between attempts
+	MaxDelay time.Duration
+}
+
+// NewDefaultRetryPolicy returns the retry policy clients use unless another
+// one is set
+func NewDefaultRetryPolicy() *DefaultRetryPolicy {
+	return &DefaultRetryPolicy{
+		MaxAttempts: 5,
+		BaseDelay:   100 * time.Millisecond,
+		MaxDelay:    30 * time.Second,
+	}
+}
+
+// ShouldRetry implements RetryPolicy
+func (p *DefaultRetryPolicy) ShouldRetry(attempt int, resp *http.Response, err error) (bool, time.Duration) {
+	if attempt >= p.MaxAttempts {
+		return false, 0
+	}
+
+	if err != nil {
+		return idempotent(requestMethod(resp, err)), p.backoff(attempt)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusForbidden:
+		// 403 is only retried when it is a rate limit response
+		if wait, ok := retryAfter(resp); ok {
+			return true, wait
+		}
+
+		if resp.StatusCode == http.StatusTooManyRequests {
+			return true, p.backoff(attempt)
+		}
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return idempotent(requestMethod(resp, err)), p.backoff(attempt)
+	}
+
+	return false, 0
+}
+
+// requestMethod returns the method of the failed request, taken from the
+// response or from the error of the http client. It is empty when unknown.
+func requestMethod(resp *http.Response, err error) string {
+	if resp != nil && resp.Request != nil {
+		return resp.Request.Method
+	}
+
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return strings.ToUpper(urlErr.Op)
+	}
+
+	return ""
+}
+
+// idempotent reports whether sending a request of the method twice has the
+// same effect as sending it once
+func idempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	return false
+}
+
+// backoff returns a random wait between the base delay and three times the
+// previous upper bound, the decorrelated jitter of the given attempt
+func (p *DefaultRetryPolicy) backoff(attempt int) time.Duration {
+	upper := float64(p.BaseDelay) * math.Pow(3, float64(attempt))
+	if upper > float64(p.MaxDelay) {
+		upper = float64(p.MaxDelay)
+	}
+
+	if upper <= float64(p.BaseDelay) {
+		return p.BaseDelay
+	}
+
+	return p.BaseDelay + time.Duration(rand.Int63n(int64(upper)-int64(p.BaseDelay)))
+}
+
+// retryAfter reads the wait the api asks for from the Retry-After or
+// X-Contentful-Ratelimit-Reset headers
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	for _, header := range []string{"Retry-After", "X-Contentful-Ratelimit-Reset"} {
+		if value := resp.Header.Get(header); value != "" {
+			seconds, err := strconv.Atoi(value)
+			if err != nil {
+				continue
+			}
+
+			return time.Second * time.Duration(seconds), true
+		}
+	}
+
+	return 0, false
+}
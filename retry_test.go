@@ -0,0 +1,170 @@
+package contentful
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type recordingRetryPolicy struct {
+	attempts []int
+	statuses []int
+}
+
+func (p *recordingRetryPolicy) ShouldRetry(attempt int, resp *http.Response, err error) (bool, time.Duration) {
+	p.attempts = append(p.attempts, attempt)
+	if resp != nil {
+		p.statuses = append(p.statuses, resp.StatusCode)
+	}
+
+	return attempt < 3, time.Millisecond
+}
+
+func TestClientRetryPolicy(t *testing.T) {
+	assert := assert.New(t)
+
+	requests := 0
+	bodies := []string{}
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		body, err := ioutil.ReadAll(r.Body)
+		assert.Nil(err)
+		bodies = append(bodies, string(body))
+
+		if requests < 3 {
+			w.WriteHeader(503)
+			fmt.Fprintln(w, `{"sys": {"type": "Error", "id": "ServerError"}}`)
+			return
+		}
+
+		w.WriteHeader(201)
+		fmt.Fprintln(w, readTestData("locale_1.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	policy := &recordingRetryPolicy{}
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+	cma.SetRetryPolicy(policy)
+
+	locale := &Locale{Name: "German (Austria)", Code: "de-AT"}
+	err := cma.Locales.Upsert(spaceID, locale)
+	assert.Nil(err)
+	assert.Equal(3, requests)
+	assert.Equal([]int{1, 2}, policy.attempts)
+	assert.Equal([]int{503, 503}, policy.statuses)
+
+	// the body is sent again on every attempt
+	assert.Equal(3, len(bodies))
+	assert.Equal(bodies[0], bodies[1])
+	assert.Equal(bodies[0], bodies[2])
+	assert.Contains(bodies[0], "de-AT")
+
+	// without a policy failed requests are not retried
+	requests = 0
+	cma.SetRetryPolicy(nil)
+	err = cma.Locales.Upsert(spaceID, locale)
+	assert.NotNil(err)
+	assert.Equal(1, requests)
+}
+
+func TestDefaultRetryPolicy(t *testing.T) {
+	assert := assert.New(t)
+
+	policy := NewDefaultRetryPolicy()
+
+	response := func(status int, headers map[string]string) *http.Response {
+		req, _ := http.NewRequest("GET", "https://api.contentful.com/spaces", nil)
+		res := &http.Response{StatusCode: status, Header: http.Header{}, Request: req}
+		for key, value := range headers {
+			res.Header.Set(key, value)
+		}
+
+		return res
+	}
+
+	retry, wait := policy.ShouldRetry(1, response(429, map[string]string{"X-Contentful-Ratelimit-Reset": "2"}), nil)
+	assert.True(retry)
+	assert.Equal(2*time.Second, wait)
+
+	retry, wait = policy.ShouldRetry(1, response(429, map[string]string{"Retry-After": "3"}), nil)
+	assert.True(retry)
+	assert.Equal(3*time.Second, wait)
+
+	retry, _ = policy.ShouldRetry(1, response(403, nil), nil)
+	assert.False(retry)
+
+	retry, _ = policy.ShouldRetry(1, response(404, nil), nil)
+	assert.False(retry)
+
+	retry, _ = policy.ShouldRetry(policy.MaxAttempts, response(503, nil), nil)
+	assert.False(retry)
+
+	for attempt := 1; attempt < policy.MaxAttempts; attempt++ {
+		retry, wait = policy.ShouldRetry(attempt, response(503, nil), nil)
+		assert.True(retry)
+		assert.True(wait >= policy.BaseDelay)
+		assert.True(wait <= policy.MaxDelay)
+	}
+
+	retry, _ = policy.ShouldRetry(1, nil, &url.Error{Op: "Get", URL: "https://api.contentful.com/spaces", Err: fmt.Errorf("connection refused")})
+	assert.True(retry)
+
+	// requests which are not idempotent are retried on rate limits only
+	post := func(status int, headers map[string]string) *http.Response {
+		res := response(status, headers)
+		res.Request.Method = "POST"
+		return res
+	}
+
+	retry, wait = policy.ShouldRetry(1, post(429, map[string]string{"Retry-After": "3"}), nil)
+	assert.True(retry)
+	assert.Equal(3*time.Second, wait)
+
+	retry, _ = policy.ShouldRetry(1, post(503, nil), nil)
+	assert.False(retry)
+
+	retry, _ = policy.ShouldRetry(1, nil, &url.Error{Op: "Post", URL: "https://api.contentful.com/spaces", Err: fmt.Errorf("connection reset")})
+	assert.False(retry)
+
+	// as are requests of unknown methods
+	retry, _ = policy.ShouldRetry(1, nil, fmt.Errorf("connection refused"))
+	assert.False(retry)
+}
+
+func TestDefaultRetryPolicyDoesNotRetryCreates(t *testing.T) {
+	assert := assert.New(t)
+
+	requests := 0
+	method := ""
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		method = r.Method
+		w.WriteHeader(503)
+		fmt.Fprintln(w, `{"sys": {"type": "Error", "id": "ServerError"}}`)
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	cma = NewCMA(CMAToken, WithBaseURL(server.URL))
+
+	// the entry may have been created, it is not created again
+	err := cma.Entries.Create(context.Background(), spaceID, "cat", &Entry{Fields: map[string]interface{}{}})
+	assert.NotNil(err)
+	assert.Equal("POST", method)
+	assert.Equal(1, requests)
+}
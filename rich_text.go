@@ -0,0 +1,286 @@
+package contentful
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	// RichTextNodeDocument rich text root node type
+	RichTextNodeDocument = "document"
+
+	// RichTextNodeParagraph rich text paragraph node type
+	RichTextNodeParagraph = "paragraph"
+
+	// RichTextNodeHeading1 rich text heading node type
+	RichTextNodeHeading1 = "heading-1"
+
+	// RichTextNodeHeading2 rich text heading node type
+	RichTextNodeHeading2 = "heading-2"
+
+	// RichTextNodeHeading3 rich text heading node type
+	RichTextNodeHeading3 = "heading-3"
+
+	// RichTextNodeHeading4 rich text heading node type
+	RichTextNodeHeading4 = "heading-4"
+
+	// RichTextNodeHeading5 rich text heading node type
+	RichTextNodeHeading5 = "heading-5"
+
+	// RichTextNodeHeading6 rich text heading node type
+	RichTextNodeHeading6 = "heading-6"
+
+	// RichTextNodeOrderedList rich text ordered list node type
+	RichTextNodeOrderedList = "ordered-list"
+
+	// RichTextNodeUnorderedList rich text unordered list node type
+	RichTextNodeUnorderedList = "unordered-list"
+
+	// RichTextNodeListItem rich text list item node type
+	RichTextNodeListItem = "list-item"
+
+	// RichTextNodeBlockquote rich text blockquote node type
+	RichTextNodeBlockquote = "blockquote"
+
+	// RichTextNodeHR rich text horizontal rule node type
+	RichTextNodeHR = "hr"
+
+	// RichTextNodeEmbeddedEntryBlock rich text embedded entry block node type
+	RichTextNodeEmbeddedEntryBlock = "embedded-entry-block"
+
+	// RichTextNodeEmbeddedAssetBlock rich text embedded asset block node type
+	RichTextNodeEmbeddedAssetBlock = "embedded-asset-block"
+
+	// RichTextNodeEmbeddedEntryInline rich text embedded inline entry node type
+	RichTextNodeEmbeddedEntryInline = "embedded-entry-inline"
+
+	// RichTextNodeHyperlink rich text hyperlink node type
+	RichTextNodeHyperlink = "hyperlink"
+
+	// RichTextNodeEntryHyperlink rich text entry hyperlink node type
+	RichTextNodeEntryHyperlink = "entry-hyperlink"
+
+	// RichTextNodeAssetHyperlink rich text asset hyperlink node type
+	RichTextNodeAssetHyperlink = "asset-hyperlink"
+
+	// RichTextNodeText rich text text node type
+	RichTextNodeText = "text"
+)
+
+const (
+	// RichTextMarkBold bold text mark
+	RichTextMarkBold = "bold"
+
+	// RichTextMarkItalic italic text mark
+	RichTextMarkItalic = "italic"
+
+	// RichTextMarkUnderline underline text mark
+	RichTextMarkUnderline = "underline"
+
+	// RichTextMarkCode code text mark
+	RichTextMarkCode = "code"
+)
+
+// RichTextDocument model, the root node of a rich text field value
+type RichTextDocument struct {
+	NodeType string                 `json:"nodeType"`
+	Data     map[string]interface{} `json:"data"`
+	Content  []*RichTextNode        `json:"content"`
+}
+
+// RichTextNode model. Text nodes carry a `Value` and `Marks`, every other
+// node type carries `Content`.
+type RichTextNode struct {
+	NodeType string                 `json:"nodeType"`
+	Data     map[string]interface{} `json:"data"`
+	Content  []*RichTextNode        `json:"content,omitempty"`
+	Value    string                 `json:"value,omitempty"`
+	Marks    []RichTextMark         `json:"marks,omitempty"`
+}
+
+// RichTextMark model
+type RichTextMark struct {
+	Type string `json:"type"`
+}
+
+// NewRichTextDocument returns a rich text document with the given nodes
+func NewRichTextDocument(content ...*RichTextNode) *RichTextDocument {
+	return &RichTextDocument{
+		NodeType: RichTextNodeDocument,
+		Data:     map[string]interface{}{},
+		Content:  content,
+	}
+}
+
+// NewRichTextNode returns a node of the given type with the given children
+func NewRichTextNode(nodeType string, content ...*RichTextNode) *RichTextNode {
+	return &RichTextNode{
+		NodeType: nodeType,
+		Data:     map[string]interface{}{},
+		Content:  content,
+	}
+}
+
+// NewRichTextParagraph returns a paragraph node with the given children
+func NewRichTextParagraph(content ...*RichTextNode) *RichTextNode {
+	return NewRichTextNode(RichTextNodeParagraph, content...)
+}
+
+// NewRichTextText returns a text node with the given marks, e.g. RichTextMarkBold
+func NewRichTextText(value string, marks ...string) *RichTextNode {
+	node := &RichTextNode{
+		NodeType: RichTextNodeText,
+		Data:     map[string]interface{}{},
+		Value:    value,
+		Marks:    []RichTextMark{},
+	}
+
+	for _, mark := range marks {
+		node.Marks = append(node.Marks, RichTextMark{Type: mark})
+	}
+
+	return node
+}
+
+// MarshalJSON for custom json marshaling, text nodes always carry `marks`
+// and every other node always carries `content`
+func (node *RichTextNode) MarshalJSON() ([]byte, error) {
+	data := node.Data
+	if data == nil {
+		data = map[string]interface{}{}
+	}
+
+	if node.NodeType == RichTextNodeText {
+		marks := node.Marks
+		if marks == nil {
+			marks = []RichTextMark{}
+		}
+
+		return json.Marshal(&struct {
+			NodeType string                 `json:"nodeType"`
+			Data     map[string]interface{} `json:"data"`
+			Value    string                 `json:"value"`
+			Marks    []RichTextMark         `json:"marks"`
+		}{
+			NodeType: node.NodeType,
+			Data:     data,
+			Value:    node.Value,
+			Marks:    marks,
+		})
+	}
+
+	content := node.Content
+	if content == nil {
+		content = []*RichTextNode{}
+	}
+
+	return json.Marshal(&struct {
+		NodeType string                 `json:"nodeType"`
+		Data     map[string]interface{} `json:"data"`
+		Content  []*RichTextNode        `json:"content"`
+	}{
+		NodeType: node.NodeType,
+		Data:     data,
+		Content:  content,
+	})
+}
+
+// HasMark reports whether a text node is marked with the given mark type
+func (node *RichTextNode) HasMark(mark string) bool {
+	for _, m := range node.Marks {
+		if m.Type == mark {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsInline reports whether the node is a text or inline node
+func (node *RichTextNode) IsInline() bool {
+	switch node.NodeType {
+	case RichTextNodeText,
+		RichTextNodeHyperlink,
+		RichTextNodeEntryHyperlink,
+		RichTextNodeAssetHyperlink,
+		RichTextNodeEmbeddedEntryInline:
+		return true
+	}
+
+	return false
+}
+
+// ToPlainText flattens the document to a string. Text of block nodes is
+// separated by a single space.
+func (doc *RichTextDocument) ToPlainText() string {
+	return richTextPlainText(doc.Content)
+}
+
+// ToPlainText flattens the node and its children to a string
+func (node *RichTextNode) ToPlainText() string {
+	if node.NodeType == RichTextNodeText {
+		return node.Value
+	}
+
+	return richTextPlainText(node.Content)
+}
+
+func richTextPlainText(nodes []*RichTextNode) string {
+	var text strings.Builder
+
+	for _, node := range nodes {
+		value := node.ToPlainText()
+
+		if !node.IsInline() {
+			if value == "" {
+				continue
+			}
+
+			if text.Len() > 0 {
+				text.WriteString(" ")
+			}
+		}
+
+		text.WriteString(value)
+	}
+
+	return text.String()
+}
+
+// GetRichText returns the rich text document stored in the given field. The
+// locale is used when the field value is a locale map, pass an empty locale
+// for entries fetched for a single locale.
+func (entry *Entry) GetRichText(fieldID, locale string) (*RichTextDocument, error) {
+	value, ok := entry.Fields[fieldID]
+	if !ok {
+		return nil, fmt.Errorf("entry has no field %q", fieldID)
+	}
+
+	if locale != "" {
+		localized, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("field %q is not localized", fieldID)
+		}
+
+		if value, ok = localized[locale]; !ok {
+			return nil, fmt.Errorf("field %q has no value for locale %q", fieldID, locale)
+		}
+	}
+
+	byteArray, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc RichTextDocument
+	if err := json.Unmarshal(byteArray, &doc); err != nil {
+		return nil, err
+	}
+
+	if doc.NodeType != RichTextNodeDocument {
+		return nil, fmt.Errorf("field %q is not a rich text document", fieldID)
+	}
+
+	return &doc, nil
+}
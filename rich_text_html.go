@@ -0,0 +1,185 @@
+package contentful
+
+import (
+	"fmt"
+	"html"
+	"net/url"
+	"strings"
+)
+
+// RichTextNodeRenderer renders a single node to html. `next` renders the
+// given child nodes with the renderer the node renderer was invoked by.
+type RichTextNodeRenderer func(node *RichTextNode, next func(nodes []*RichTextNode) (string, error)) (string, error)
+
+// RichTextRenderOptions holds the rich text html rendering options
+type RichTextRenderOptions struct {
+	// NodeRenderers overrides the rendering of the given node types, it is
+	// the place to render embedded entries and assets which are otherwise
+	// left out of the output
+	NodeRenderers map[string]RichTextNodeRenderer
+
+	// Strict fails the rendering on unknown node types and marks, which are
+	// otherwise rendered as their content and ignored respectively, and on
+	// null nodes, which are otherwise left out
+	Strict bool
+}
+
+// RichTextRenderer renders rich text documents to html
+type RichTextRenderer struct {
+	options RichTextRenderOptions
+}
+
+var richTextBlockTags = map[string]string{
+	RichTextNodeParagraph:     "p",
+	RichTextNodeHeading1:      "h1",
+	RichTextNodeHeading2:      "h2",
+	RichTextNodeHeading3:      "h3",
+	RichTextNodeHeading4:      "h4",
+	RichTextNodeHeading5:      "h5",
+	RichTextNodeHeading6:      "h6",
+	RichTextNodeOrderedList:   "ol",
+	RichTextNodeUnorderedList: "ul",
+	RichTextNodeListItem:      "li",
+	RichTextNodeBlockquote:    "blockquote",
+}
+
+var richTextMarkTags = map[string]string{
+	RichTextMarkBold:      "b",
+	RichTextMarkItalic:    "i",
+	RichTextMarkUnderline: "u",
+	RichTextMarkCode:      "code",
+}
+
+// NewRichTextRenderer returns a renderer with the given options
+func NewRichTextRenderer(options RichTextRenderOptions) *RichTextRenderer {
+	return &RichTextRenderer{
+		options: options,
+	}
+}
+
+// RenderToHTML renders the document to html with the given options
+func RenderToHTML(doc *RichTextDocument, options RichTextRenderOptions) (string, error) {
+	return NewRichTextRenderer(options).Render(doc)
+}
+
+// Render renders the document to html
+func (r *RichTextRenderer) Render(doc *RichTextDocument) (string, error) {
+	if doc == nil {
+		return "", nil
+	}
+
+	return r.renderNodes(doc.Content)
+}
+
+func (r *RichTextRenderer) renderNodes(nodes []*RichTextNode) (string, error) {
+	var out strings.Builder
+
+	for _, node := range nodes {
+		if node == nil {
+			if r.options.Strict {
+				return "", fmt.Errorf("null rich text node")
+			}
+
+			continue
+		}
+
+		rendered, err := r.renderNode(node)
+		if err != nil {
+			return "", err
+		}
+
+		out.WriteString(rendered)
+	}
+
+	return out.String(), nil
+}
+
+func (r *RichTextRenderer) renderNode(node *RichTextNode) (string, error) {
+	if renderer, ok := r.options.NodeRenderers[node.NodeType]; ok {
+		return renderer(node, r.renderNodes)
+	}
+
+	if tag, ok := richTextBlockTags[node.NodeType]; ok {
+		content, err := r.renderNodes(node.Content)
+		if err != nil {
+			return "", err
+		}
+
+		return "<" + tag + ">" + content + "</" + tag + ">", nil
+	}
+
+	switch node.NodeType {
+	case RichTextNodeText:
+		text := html.EscapeString(node.Value)
+
+		for _, mark := range node.Marks {
+			tag, ok := richTextMarkTags[mark.Type]
+			if !ok {
+				if r.options.Strict {
+					return "", fmt.Errorf("unknown rich text mark %q", mark.Type)
+				}
+
+				continue
+			}
+
+			text = "<" + tag + ">" + text + "</" + tag + ">"
+		}
+
+		return text, nil
+	case RichTextNodeHR:
+		return "<hr/>", nil
+	case RichTextNodeHyperlink:
+		content, err := r.renderNodes(node.Content)
+		if err != nil {
+			return "", err
+		}
+
+		uri, _ := node.Data["uri"].(string)
+
+		// links of other schemes, e.g. javascript:, are left out
+		uri, ok := safeURI(uri)
+		if !ok {
+			return content, nil
+		}
+
+		return `<a href="` + html.EscapeString(uri) + `">` + content + "</a>", nil
+	case RichTextNodeEntryHyperlink, RichTextNodeAssetHyperlink:
+		// links to entries and assets can only be resolved by the caller
+		return r.renderNodes(node.Content)
+	case RichTextNodeEmbeddedEntryBlock, RichTextNodeEmbeddedAssetBlock, RichTextNodeEmbeddedEntryInline:
+		return "", nil
+	}
+
+	if r.options.Strict {
+		return "", fmt.Errorf("unknown rich text node type %q", node.NodeType)
+	}
+
+	return r.renderNodes(node.Content)
+}
+
+// safeURISchemes are the schemes of the uris hyperlinks are rendered with
+var safeURISchemes = map[string]bool{
+	"":       true,
+	"http":   true,
+	"https":  true,
+	"mailto": true,
+	"tel":    true,
+}
+
+// safeURI returns the uri when it is relative or of a safe scheme. Tabs and
+// newlines are removed first, as browsers ignore them in urls.
+func safeURI(uri string) (string, bool) {
+	uri = strings.Map(func(r rune) rune {
+		if r == '\t' || r == '\n' || r == '\r' {
+			return -1
+		}
+		return r
+	}, strings.TrimSpace(uri))
+
+	u, err := url.Parse(uri)
+	if err != nil || !safeURISchemes[strings.ToLower(u.Scheme)] {
+		return "", false
+	}
+
+	return uri, true
+}
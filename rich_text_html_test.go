@@ -0,0 +1,148 @@
+package contentful
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderToHTML(t *testing.T) {
+	var err error
+	assert := assert.New(t)
+
+	doc := NewRichTextDocument(
+		NewRichTextNode(RichTextNodeHeading1, NewRichTextText("Title")),
+		NewRichTextParagraph(
+			NewRichTextText("a "),
+			NewRichTextText("b", RichTextMarkBold),
+			NewRichTextText("i", RichTextMarkItalic),
+			NewRichTextText("u", RichTextMarkUnderline),
+			NewRichTextText("c", RichTextMarkCode),
+			NewRichTextText("<&>"),
+		),
+		NewRichTextNode(RichTextNodeUnorderedList,
+			NewRichTextNode(RichTextNodeListItem, NewRichTextParagraph(NewRichTextText("one"))),
+			NewRichTextNode(RichTextNodeListItem, NewRichTextParagraph(NewRichTextText("two"))),
+		),
+		NewRichTextNode(RichTextNodeOrderedList,
+			NewRichTextNode(RichTextNodeListItem, NewRichTextParagraph(NewRichTextText("first"))),
+		),
+		NewRichTextNode(RichTextNodeBlockquote, NewRichTextParagraph(NewRichTextText("quote"))),
+		NewRichTextNode(RichTextNodeHR),
+		NewRichTextParagraph(&RichTextNode{
+			NodeType: RichTextNodeHyperlink,
+			Data:     map[string]interface{}{"uri": "https://example.com/?a=1&b=2"},
+			Content:  []*RichTextNode{NewRichTextText("link")},
+		}),
+	)
+
+	out, err := RenderToHTML(doc, RichTextRenderOptions{})
+	assert.Nil(err)
+	assert.Equal("<h1>Title</h1>"+
+		"<p>a <b>b</b><i>i</i><u>u</u><code>c</code>&lt;&amp;&gt;</p>"+
+		"<ul><li><p>one</p></li><li><p>two</p></li></ul>"+
+		"<ol><li><p>first</p></li></ol>"+
+		"<blockquote><p>quote</p></blockquote>"+
+		"<hr/>"+
+		`<p><a href="https://example.com/?a=1&amp;b=2">link</a></p>`, out)
+
+	// multiple marks nest
+	out, err = RenderToHTML(NewRichTextDocument(
+		NewRichTextParagraph(NewRichTextText("x", RichTextMarkBold, RichTextMarkItalic)),
+	), RichTextRenderOptions{})
+	assert.Nil(err)
+	assert.Equal("<p><i><b>x</b></i></p>", out)
+
+	// unknown node types are rendered as their content, unknown marks are
+	// ignored, unless rendering strictly
+	doc = NewRichTextDocument(NewRichTextNode("table",
+		NewRichTextNode("table-row", NewRichTextNode("table-cell", NewRichTextParagraph(NewRichTextText("x", "superscript")))),
+	))
+	out, err = RenderToHTML(doc, RichTextRenderOptions{})
+	assert.Nil(err)
+	assert.Equal("<p>x</p>", out)
+
+	_, err = RenderToHTML(doc, RichTextRenderOptions{Strict: true})
+	assert.NotNil(err)
+
+	_, err = RenderToHTML(NewRichTextDocument(NewRichTextParagraph(NewRichTextText("x", "superscript"))), RichTextRenderOptions{Strict: true})
+	assert.NotNil(err)
+}
+
+func TestRenderToHTMLHyperlinkSchemes(t *testing.T) {
+	assert := assert.New(t)
+
+	link := func(uri string) string {
+		out, err := RenderToHTML(NewRichTextDocument(NewRichTextParagraph(&RichTextNode{
+			NodeType: RichTextNodeHyperlink,
+			Data:     map[string]interface{}{"uri": uri},
+			Content:  []*RichTextNode{NewRichTextText("link")},
+		})), RichTextRenderOptions{})
+		assert.Nil(err)
+		return out
+	}
+
+	assert.Equal(`<p><a href="http://example.com">link</a></p>`, link("http://example.com"))
+	assert.Equal(`<p><a href="mailto:cat@example.com">link</a></p>`, link("mailto:cat@example.com"))
+	assert.Equal(`<p><a href="tel:+441234">link</a></p>`, link("tel:+441234"))
+	assert.Equal(`<p><a href="/cats?name=nyan">link</a></p>`, link("/cats?name=nyan"))
+	assert.Equal(`<p><a href="#top">link</a></p>`, link("#top"))
+
+	// unsafe schemes are left out, the link's content is kept
+	assert.Equal("<p>link</p>", link("javascript:alert(1)"))
+	assert.Equal("<p>link</p>", link(" JavaScript:alert(1)"))
+	assert.Equal("<p>link</p>", link("java\tscript:alert(1)"))
+	assert.Equal("<p>link</p>", link("data:text/html;base64,PHNjcmlwdD4="))
+	assert.Equal("<p>link</p>", link("vbscript:msgbox(1)"))
+}
+
+func TestRenderToHTMLNullNodes(t *testing.T) {
+	assert := assert.New(t)
+
+	var doc RichTextDocument
+	err := json.Unmarshal([]byte(`{"nodeType": "document", "content": [
+		null,
+		{"nodeType": "paragraph", "content": [null, {"nodeType": "text", "value": "Nyan Cat", "marks": []}]}
+	]}`), &doc)
+	assert.Nil(err)
+
+	out, err := RenderToHTML(&doc, RichTextRenderOptions{})
+	assert.Nil(err)
+	assert.Equal("<p>Nyan Cat</p>", out)
+
+	_, err = RenderToHTML(&doc, RichTextRenderOptions{Strict: true})
+	assert.NotNil(err)
+}
+
+func TestRenderToHTMLNodeRenderers(t *testing.T) {
+	var err error
+	assert := assert.New(t)
+
+	entry, err := entryFromTestData("entry_rich_text.json")
+	assert.Nil(err)
+
+	doc, err := entry.GetRichText("body", "en-US")
+	assert.Nil(err)
+
+	// embedded entries are left out without a renderer
+	out, err := RenderToHTML(doc, RichTextRenderOptions{})
+	assert.Nil(err)
+	assert.Equal(`<h1>A title</h1><p>Some <b>bold</b> text and a <a href="https://www.contentful.com">link</a>.</p>`, out)
+
+	out, err = RenderToHTML(doc, RichTextRenderOptions{
+		NodeRenderers: map[string]RichTextNodeRenderer{
+			RichTextNodeEmbeddedEntryBlock: func(node *RichTextNode, next func([]*RichTextNode) (string, error)) (string, error) {
+				target := node.Data["target"].(map[string]interface{})
+				sys := target["sys"].(map[string]interface{})
+				return `<div data-entry="` + sys["id"].(string) + `"></div>`, nil
+			},
+			RichTextNodeHeading1: func(node *RichTextNode, next func([]*RichTextNode) (string, error)) (string, error) {
+				content, err := next(node.Content)
+				return `<h1 class="title">` + content + "</h1>", err
+			},
+		},
+	})
+	assert.Nil(err)
+	assert.Equal(`<h1 class="title">A title</h1><p>Some <b>bold</b> text and a <a href="https://www.contentful.com">link</a>.</p><div data-entry="nyancat"></div>`, out)
+}
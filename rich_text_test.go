@@ -0,0 +1,94 @@
+package contentful
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func entryFromTestData(fileName string) (*Entry, error) {
+	content := readTestData(fileName)
+
+	var entry Entry
+	err := json.NewDecoder(strings.NewReader(content)).Decode(&entry)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entry, nil
+}
+
+func TestEntryGetRichText(t *testing.T) {
+	var err error
+	assert := assert.New(t)
+
+	entry, err := entryFromTestData("entry_rich_text.json")
+	assert.Nil(err)
+
+	doc, err := entry.GetRichText("body", "en-US")
+	assert.Nil(err)
+	assert.Equal(RichTextNodeDocument, doc.NodeType)
+	assert.Equal(3, len(doc.Content))
+
+	paragraph := doc.Content[1]
+	assert.Equal(RichTextNodeParagraph, paragraph.NodeType)
+	assert.True(paragraph.Content[1].HasMark(RichTextMarkBold))
+	assert.False(paragraph.Content[0].HasMark(RichTextMarkBold))
+	assert.Equal("https://www.contentful.com", paragraph.Content[3].Data["uri"])
+
+	assert.Equal("A title Some bold text and a link.", doc.ToPlainText())
+
+	_, err = entry.GetRichText("body", "de-DE")
+	assert.NotNil(err)
+
+	_, err = entry.GetRichText("title", "en-US")
+	assert.NotNil(err)
+
+	_, err = entry.GetRichText("missing", "en-US")
+	assert.NotNil(err)
+}
+
+func TestRichTextDocumentMarshal(t *testing.T) {
+	var err error
+	assert := assert.New(t)
+
+	doc := NewRichTextDocument(
+		NewRichTextNode(RichTextNodeHeading2, NewRichTextText("Heading")),
+		NewRichTextParagraph(
+			NewRichTextText("plain "),
+			NewRichTextText("emphasised", RichTextMarkItalic, RichTextMarkBold),
+		),
+		NewRichTextNode(RichTextNodeHR),
+	)
+
+	data, err := json.Marshal(doc)
+	assert.Nil(err)
+	assert.JSONEq(`{
+		"nodeType": "document",
+		"data": {},
+		"content": [
+			{"nodeType": "heading-2", "data": {}, "content": [
+				{"nodeType": "text", "data": {}, "value": "Heading", "marks": []}
+			]},
+			{"nodeType": "paragraph", "data": {}, "content": [
+				{"nodeType": "text", "data": {}, "value": "plain ", "marks": []},
+				{"nodeType": "text", "data": {}, "value": "emphasised", "marks": [{"type": "italic"}, {"type": "bold"}]}
+			]},
+			{"nodeType": "hr", "data": {}, "content": []}
+		]
+	}`, string(data))
+
+	entry := &Entry{
+		Fields: map[string]interface{}{
+			"body": map[string]interface{}{
+				"en-US": doc,
+			},
+		},
+	}
+
+	parsed, err := entry.GetRichText("body", "en-US")
+	assert.Nil(err)
+	assert.Equal("Heading plain emphasised", parsed.ToPlainText())
+}
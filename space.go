@@ -3,7 +3,6 @@ package contentful
 import (
 	"bytes"
 	"encoding/json"
-	"fmt"
 	"net/http"
 	"strconv"
 )
@@ -41,7 +40,7 @@ func (space *Space) GetVersion() int {
 
 // List creates a spaces collection
 func (service *SpacesService) List() *Collection {
-	req, _ := service.c.newRequest("GET", "/spaces", nil, nil)
+	req, _ := service.c.newRequest("GET", pathf("/spaces"), nil, nil)
 
 	col := NewCollection(&CollectionOptions{})
 	col.c = service.c
@@ -52,7 +51,7 @@ func (service *SpacesService) List() *Collection {
 
 // Get returns a single space entity
 func (service *SpacesService) Get(spaceID string) (*Space, error) {
-	path := fmt.Sprintf("/spaces/%s", spaceID)
+	path := pathf("/spaces/%s", spaceID)
 	req, err := service.c.newRequest(http.MethodGet, path, nil, nil)
 	if err != nil {
 		return &Space{}, err
@@ -73,14 +72,14 @@ func (service *SpacesService) Upsert(space *Space) error {
 		return err
 	}
 
-	var path string
+	var path apiPath
 	var method string
 
 	if space.Sys != nil && space.Sys.CreatedAt != "" {
-		path = fmt.Sprintf("/spaces/%s", space.Sys.ID)
+		path = pathf("/spaces/%s", space.Sys.ID)
 		method = http.MethodPut
 	} else {
-		path = "/spaces"
+		path = pathf("/spaces")
 		method = http.MethodPost
 	}
 
@@ -89,14 +88,14 @@ func (service *SpacesService) Upsert(space *Space) error {
 		return err
 	}
 
-	req.Header.Set("X-Contentful-Version", strconv.Itoa(space.GetVersion()))
+	setVersionHeader(req, space.Sys)
 
 	return service.c.do(req, space)
 }
 
 // Delete the given space
 func (service *SpacesService) Delete(space *Space) error {
-	path := fmt.Sprintf("/spaces/%s", space.Sys.ID)
+	path := pathf("/spaces/%s", space.Sys.ID)
 
 	req, err := service.c.newRequest(http.MethodDelete, path, nil, nil)
 	if err != nil {
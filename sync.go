@@ -0,0 +1,163 @@
+package contentful
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// SyncService service
+type SyncService service
+
+const (
+	// SyncTypeAll syncs entries, assets and their deletions
+	SyncTypeAll = "all"
+
+	// SyncTypeEntry syncs entries only
+	SyncTypeEntry = "Entry"
+
+	// SyncTypeAsset syncs assets only
+	SyncTypeAsset = "Asset"
+
+	// SyncTypeDeletion syncs deleted entries and assets only
+	SyncTypeDeletion = "Deletion"
+
+	// SyncTypeDeletedEntry syncs deleted entries only
+	SyncTypeDeletedEntry = "DeletedEntry"
+
+	// SyncTypeDeletedAsset syncs deleted assets only
+	SyncTypeDeletedAsset = "DeletedAsset"
+)
+
+// SyncResult model, the changes since the initial or the previous sync.
+// Pass NextSyncToken to Continue in order to get the following changes.
+type SyncResult struct {
+	Entries        []*Entry
+	Assets         []*Asset
+	DeletedEntries []*Entry
+	DeletedAssets  []*Asset
+	NextSyncToken  string
+}
+
+type syncPage struct {
+	Items       []json.RawMessage `json:"items"`
+	NextPageURL string            `json:"nextPageUrl"`
+	NextSyncURL string            `json:"nextSyncUrl"`
+}
+
+// Initial syncs the whole content of the space environment. typeFilter can
+// be one of the SyncType constants, an empty filter syncs everything.
+func (service *SyncService) Initial(ctx context.Context, spaceID string, typeFilter string) (*SyncResult, error) {
+	query := url.Values{}
+	query.Set("initial", "true")
+
+	if typeFilter != "" && typeFilter != SyncTypeAll {
+		query.Set("type", typeFilter)
+	}
+
+	return service.sync(ctx, spaceID, query)
+}
+
+// Continue syncs the changes since the sync which returned the syncToken
+func (service *SyncService) Continue(ctx context.Context, spaceID, syncToken string) (*SyncResult, error) {
+	query := url.Values{}
+	query.Set("sync_token", syncToken)
+
+	return service.sync(ctx, spaceID, query)
+}
+
+// sync follows the pages of a sync until the next sync token is returned
+func (service *SyncService) sync(ctx context.Context, spaceID string, query url.Values) (*SyncResult, error) {
+	path := service.c.envPath(spaceID, "/sync")
+	result := &SyncResult{}
+
+	for {
+		req, err := service.c.newRequest(http.MethodGet, path, query, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var page syncPage
+		if err := service.c.do(withContext(req, ctx), &page); err != nil {
+			return nil, err
+		}
+
+		if err := result.add(page.Items); err != nil {
+			return nil, err
+		}
+
+		if page.NextPageURL == "" {
+			token, err := syncToken(page.NextSyncURL)
+			if err != nil {
+				return nil, err
+			}
+
+			result.NextSyncToken = token
+
+			return result, nil
+		}
+
+		token, err := syncToken(page.NextPageURL)
+		if err != nil {
+			return nil, err
+		}
+
+		query = url.Values{}
+		query.Set("sync_token", token)
+	}
+}
+
+func (result *SyncResult) add(items []json.RawMessage) error {
+	for _, item := range items {
+		var typed struct {
+			Sys *Sys `json:"sys"`
+		}
+		if err := json.Unmarshal(item, &typed); err != nil {
+			return err
+		}
+
+		if typed.Sys == nil {
+			return fmt.Errorf("sync item without sys")
+		}
+
+		switch typed.Sys.Type {
+		case "Entry":
+			var entry Entry
+			if err := json.Unmarshal(item, &entry); err != nil {
+				return err
+			}
+
+			result.Entries = append(result.Entries, &entry)
+		case "Asset":
+			var asset Asset
+			if err := json.Unmarshal(item, &asset); err != nil {
+				return err
+			}
+
+			result.Assets = append(result.Assets, &asset)
+		case "DeletedEntry":
+			result.DeletedEntries = append(result.DeletedEntries, &Entry{Sys: typed.Sys})
+		case "DeletedAsset":
+			result.DeletedAssets = append(result.DeletedAssets, &Asset{Sys: typed.Sys})
+		}
+	}
+
+	return nil
+}
+
+// syncToken reads the sync_token query parameter of a next page/sync url
+func syncToken(rawURL string) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", err
+	}
+
+	token := u.Query().Get("sync_token")
+	if token == "" {
+		return "", fmt.Errorf("no sync token in %q", rawURL)
+	}
+
+	return token, nil
+}
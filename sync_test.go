@@ -0,0 +1,120 @@
+package contentful
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func ExampleSyncService_Initial() {
+	cda := NewCDA("cda-token")
+
+	result, err := cda.Sync.Initial(context.Background(), "space-id", SyncTypeAll)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, entry := range result.Entries {
+		fmt.Println(entry.Sys.ID)
+	}
+
+	// store the token and pass it to Continue to get the following changes
+	result, err = cda.Sync.Continue(context.Background(), "space-id", result.NextSyncToken)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func TestSyncServiceInitial(t *testing.T) {
+	var err error
+	assert := assert.New(t)
+
+	requests := 0
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		assert.Equal(r.Method, "GET")
+		assert.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/sync")
+
+		w.WriteHeader(200)
+
+		if r.URL.Query().Get("initial") == "true" {
+			assert.Equal("Entry", r.URL.Query().Get("type"))
+			fmt.Fprintln(w, readTestData("sync-initial.json"))
+			return
+		}
+
+		assert.Equal("page2token", r.URL.Query().Get("sync_token"))
+		fmt.Fprintln(w, readTestData("sync-page2.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cda client
+	cda := NewCDA(CDAToken)
+	cda.BaseURL = server.URL
+
+	result, err := cda.Sync.Initial(context.Background(), spaceID, SyncTypeEntry)
+	assert.Nil(err)
+	assert.Equal(2, requests)
+	assert.Equal("nexttoken", result.NextSyncToken)
+
+	assert.Equal(1, len(result.Entries))
+	assert.Equal("nyancat", result.Entries[0].Sys.ID)
+	assert.Equal(map[string]interface{}{"en-US": "Nyan Cat", "tlh": "Nyan vIghro'"}, result.Entries[0].Fields["name"])
+
+	assert.Equal(1, len(result.Assets))
+	assert.Equal("Nyan Cat", result.Assets[0].Fields.Title)
+	assert.Equal("Nyan_cat_250px_frame.png", result.Assets[0].Fields.File.Name)
+	assert.Equal(250, result.Assets[0].Fields.File.Detail.Image.Width)
+
+	assert.Equal(1, len(result.DeletedEntries))
+	assert.Equal("happycat", result.DeletedEntries[0].Sys.ID)
+	assert.Equal("2013-11-18T15:58:02.018Z", result.DeletedEntries[0].Sys.DeletedAt)
+
+	assert.Equal(1, len(result.DeletedAssets))
+	assert.Equal("happycat", result.DeletedAssets[0].Sys.ID)
+}
+
+func TestSyncServiceContinue(t *testing.T) {
+	var err error
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(r.Method, "GET")
+		assert.Equal(r.URL.Path, "/spaces/"+spaceID+"/environments/master/sync")
+		assert.Equal("", r.URL.Query().Get("initial"))
+		assert.Equal("synctoken", r.URL.Query().Get("sync_token"))
+
+		w.WriteHeader(200)
+		fmt.Fprintln(w, readTestData("sync-page2.json"))
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cda client
+	cda := NewCDA(CDAToken)
+	cda.BaseURL = server.URL
+
+	result, err := cda.Sync.Continue(context.Background(), spaceID, "synctoken")
+	assert.Nil(err)
+	assert.Equal("nexttoken", result.NextSyncToken)
+	assert.Equal(0, len(result.Entries))
+	assert.Equal(1, len(result.DeletedEntries))
+
+	// a cancelled context stops the sync
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = cda.Sync.Continue(ctx, spaceID, "synctoken")
+	assert.NotNil(err)
+}
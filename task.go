@@ -0,0 +1,150 @@
+package contentful
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+const (
+	// TaskStatusActive is the status of open tasks
+	TaskStatusActive = "active"
+
+	// TaskStatusResolved is the status of done tasks
+	TaskStatusResolved = "resolved"
+)
+
+// TasksService service
+type TasksService service
+
+// Task model, a task on an entry assigned to a user, see NewLink("User", id)
+type Task struct {
+	Sys        *Sys   `json:"sys,omitempty"`
+	Body       string `json:"body"`
+	AssignedTo *Link  `json:"assignedTo"`
+	Status     string `json:"status"`
+}
+
+// GetVersion returns entity version
+func (task *Task) GetVersion() int {
+	version := 1
+	if task.Sys != nil {
+		version = task.Sys.Version
+	}
+
+	return version
+}
+
+// List returns the tasks collection of the entry
+func (service *TasksService) List(spaceID, entryID string) *Collection {
+	path := service.c.envPath(spaceID, "/entries/%s/tasks", entryID)
+	method := "GET"
+
+	req, err := service.c.newRequest(method, path, nil, nil)
+	if err != nil {
+		return &Collection{}
+	}
+
+	col := NewCollection(&CollectionOptions{})
+	col.c = service.c
+	col.req = req
+
+	return col
+}
+
+// Get returns a single task of the entry
+func (service *TasksService) Get(spaceID, entryID, taskID string) (*Task, error) {
+	path := service.c.envPath(spaceID, "/entries/%s/tasks/%s", entryID, taskID)
+	method := "GET"
+
+	req, err := service.c.newRequest(method, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var task Task
+	if err := service.c.do(req, &task); err != nil {
+		return nil, err
+	}
+
+	return &task, nil
+}
+
+// Create creates a task on the entry, tasks are active unless another status
+// is given
+func (service *TasksService) Create(spaceID, entryID string, task *Task) error {
+	if task.Status == "" {
+		task.Status = TaskStatusActive
+	}
+
+	bytesArray, err := json.Marshal(task.payload())
+	if err != nil {
+		return err
+	}
+
+	path := service.c.envPath(spaceID, "/entries/%s/tasks", entryID)
+	method := "POST"
+
+	req, err := service.c.newRequest(method, path, nil, bytes.NewReader(bytesArray))
+	if err != nil {
+		return err
+	}
+
+	return service.c.do(req, task)
+}
+
+// Update updates the task, e.g. to resolve it
+func (service *TasksService) Update(spaceID, entryID string, task *Task) error {
+	if task.Sys == nil || task.Sys.ID == "" {
+		return fmt.Errorf("updating a task requires a task id")
+	}
+
+	bytesArray, err := json.Marshal(task.payload())
+	if err != nil {
+		return err
+	}
+
+	path := service.c.envPath(spaceID, "/entries/%s/tasks/%s", entryID, task.Sys.ID)
+	method := "PUT"
+
+	req, err := service.c.newRequest(method, path, nil, bytes.NewReader(bytesArray))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("X-Contentful-Version", strconv.Itoa(task.GetVersion()))
+
+	return service.c.do(req, task)
+}
+
+// Resolve sets the status of the task to resolved
+func (service *TasksService) Resolve(spaceID, entryID string, task *Task) error {
+	task.Status = TaskStatusResolved
+
+	return service.Update(spaceID, entryID, task)
+}
+
+// Delete the task
+func (service *TasksService) Delete(spaceID, entryID string, task *Task) error {
+	path := service.c.envPath(spaceID, "/entries/%s/tasks/%s", entryID, task.Sys.ID)
+	method := "DELETE"
+
+	req, err := service.c.newRequest(method, path, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("X-Contentful-Version", strconv.Itoa(task.GetVersion()))
+
+	return service.c.do(req, nil)
+}
+
+// payload returns the task without its sys, the fields the api takes
+func (task *Task) payload() *Task {
+	return &Task{
+		Body:       task.Body,
+		AssignedTo: task.AssignedTo,
+		Status:     task.Status,
+	}
+}
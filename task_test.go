@@ -0,0 +1,79 @@
+package contentful
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTasksServiceResolve(t *testing.T) {
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("PUT", r.Method)
+		assert.Equal("/spaces/"+spaceID+"/environments/master/entries/nyancat/tasks/task-1", r.URL.Path)
+		assert.Equal("3", r.Header.Get("X-Contentful-Version"))
+
+		var payload map[string]interface{}
+		assert.Nil(json.NewDecoder(r.Body).Decode(&payload))
+		assert.Equal("resolved", payload["status"])
+		assert.Equal("Add alt texts", payload["body"])
+		assert.Nil(payload["sys"])
+
+		fmt.Fprintln(w, `{
+			"sys": {"id": "task-1", "type": "Task", "version": 4},
+			"body": "Add alt texts",
+			"status": "resolved",
+			"assignedTo": {"sys": {"type": "Link", "linkType": "User", "id": "user-1"}}
+		}`)
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	cma = NewCMA(CMAToken, WithBaseURL(server.URL))
+
+	task := &Task{
+		Sys:        &Sys{ID: "task-1", Version: 3},
+		Body:       "Add alt texts",
+		AssignedTo: NewLink("User", "user-1"),
+		Status:     TaskStatusActive,
+	}
+
+	assert.Nil(cma.Tasks.Resolve(spaceID, "nyancat", task))
+	assert.Equal(TaskStatusResolved, task.Status)
+	assert.Equal(4, task.Sys.Version)
+	assert.Equal("user-1", task.AssignedTo.Sys.ID)
+}
+
+func TestTasksServiceCreate(t *testing.T) {
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("POST", r.Method)
+		assert.Equal("/spaces/"+spaceID+"/environments/master/entries/nyancat/tasks", r.URL.Path)
+
+		var payload map[string]interface{}
+		assert.Nil(json.NewDecoder(r.Body).Decode(&payload))
+		assert.Equal("active", payload["status"])
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintln(w, `{"sys": {"id": "task-1", "version": 1}, "body": "Add alt texts", "status": "active"}`)
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	cma = NewCMA(CMAToken, WithBaseURL(server.URL))
+
+	task := &Task{Body: "Add alt texts", AssignedTo: NewLink("User", "user-1")}
+	assert.Nil(cma.Tasks.Create(spaceID, "nyancat", task))
+	assert.Equal("task-1", task.Sys.ID)
+
+	// updating requires the id
+	assert.NotNil(cma.Tasks.Update(spaceID, "nyancat", &Task{}))
+}
@@ -0,0 +1,72 @@
+package contentful
+
+import (
+	"context"
+	"net/http"
+)
+
+// Tracer starts a span around every request made by a client, the span is
+// ended by calling the returned func with the error of the request, if any.
+// The RequestInfo of the request is in the span's context, see
+// RequestInfoFromContext, which allows bridging to e.g. OpenTelemetry:
+//
+//	func (t otelTracer) StartSpan(ctx context.Context, name string) (context.Context, func(error)) {
+//		ctx, span := t.tracer.Start(ctx, name)
+//		return ctx, func(err error) {
+//			if info, ok := contentful.RequestInfoFromContext(ctx); ok {
+//				span.SetAttributes(
+//					attribute.String("http.method", info.Method),
+//					attribute.String("http.route", info.PathTemplate),
+//					attribute.Int("http.status_code", info.StatusCode),
+//				)
+//			}
+//			if err != nil {
+//				span.RecordError(err)
+//			}
+//			span.End()
+//		}
+//	}
+type Tracer interface {
+	StartSpan(ctx context.Context, name string) (context.Context, func(err error))
+}
+
+// RequestInfo describes the request a span is started for. PathTemplate is
+// the path with the ids replaced by {id}, e.g. /spaces/{id}/entries/{id}.
+// StatusCode is set once the request is done, it is 0 when no response was
+// received.
+type RequestInfo struct {
+	Method       string
+	Path         string
+	PathTemplate string
+	StatusCode   int
+}
+
+type requestInfoKey struct{}
+
+// RequestInfoFromContext returns the RequestInfo of the request the context
+// was passed to Tracer.StartSpan for
+func RequestInfoFromContext(ctx context.Context) (*RequestInfo, bool) {
+	info, ok := ctx.Value(requestInfoKey{}).(*RequestInfo)
+	return info, ok
+}
+
+type noopTracer struct{}
+
+func (noopTracer) StartSpan(ctx context.Context, name string) (context.Context, func(err error)) {
+	return ctx, func(error) {}
+}
+
+// startSpan starts a span for the request with the given tracer, the request
+// returned carries the span's context
+func startSpan(tracer Tracer, req *http.Request) (*http.Request, *RequestInfo, func(err error)) {
+	info := &RequestInfo{
+		Method:       req.Method,
+		Path:         req.URL.Path,
+		PathTemplate: requestPathTemplate(req),
+	}
+
+	ctx := context.WithValue(req.Context(), requestInfoKey{}, info)
+	ctx, end := tracer.StartSpan(ctx, "contentful "+req.Method)
+
+	return req.WithContext(ctx), info, end
+}
@@ -0,0 +1,91 @@
+package contentful
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeTracer struct {
+	mu    sync.Mutex
+	names []string
+	infos []RequestInfo
+	errs  []error
+}
+
+func (t *fakeTracer) StartSpan(ctx context.Context, name string) (context.Context, func(err error)) {
+	t.mu.Lock()
+	t.names = append(t.names, name)
+	t.mu.Unlock()
+
+	return ctx, func(err error) {
+		info, _ := RequestInfoFromContext(ctx)
+
+		t.mu.Lock()
+		defer t.mu.Unlock()
+
+		t.infos = append(t.infos, *info)
+		t.errs = append(t.errs, err)
+	}
+}
+
+func TestTracer(t *testing.T) {
+	assert := assert.New(t)
+
+	attempts := 0
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == fmt.Sprintf("/spaces/%s/environments/master/entries/missing", spaceID) {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprintln(w, readTestData("error-notfound.json"))
+			return
+		}
+
+		// the first attempt fails, the retry is part of the same span
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		fmt.Fprintln(w, readTestData("entry_3.json"))
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	tracer := &fakeTracer{}
+	cma := NewCMA(CMAToken,
+		WithBaseURL(server.URL),
+		WithTracer(tracer),
+		WithRetryPolicy(&DefaultRetryPolicy{MaxAttempts: 2}),
+	)
+
+	_, err := cma.Entries.Get(spaceID, "5KsDBWseXY6QegucYAoacS")
+	assert.Nil(err)
+
+	err = cma.Entries.Delete(spaceID, "missing")
+	assert.IsType(NotFoundError{}, err)
+
+	assert.Equal(2, attempts)
+	assert.Equal([]string{"contentful GET", "contentful DELETE"}, tracer.names)
+	assert.Equal([]RequestInfo{
+		{Method: "GET", Path: "/spaces/" + spaceID + "/environments/master/entries/5KsDBWseXY6QegucYAoacS", PathTemplate: "/spaces/{id}/environments/{id}/entries/{id}", StatusCode: 200},
+		{Method: "DELETE", Path: "/spaces/" + spaceID + "/environments/master/entries/missing", PathTemplate: "/spaces/{id}/environments/{id}/entries/{id}", StatusCode: 404},
+	}, tracer.infos)
+	assert.Nil(tracer.errs[0])
+	assert.Equal(err, tracer.errs[1])
+}
+
+func TestTracerDefault(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(noopTracer{}, NewCMA(CMAToken).tracer)
+	assert.Equal(noopTracer{}, NewCDA(CDAToken).tracer)
+	assert.Equal(noopTracer{}, NewCPA(CPAToken).tracer)
+	assert.Equal(noopTracer{}, NewCMA(CMAToken, WithTracer(nil)).tracer)
+}
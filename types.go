@@ -6,15 +6,114 @@ type Sys struct {
 	Type             string       `json:"type,omitempty"`
 	LinkType         string       `json:"linkType,omitempty"`
 	CreatedAt        string       `json:"createdAt,omitempty"`
+	CreatedBy        *Link        `json:"createdBy,omitempty"`
 	UpdatedAt        string       `json:"updatedAt,omitempty"`
-	UpdatedBy        *Sys         `json:"updatedBy,omitempty"`
+	UpdatedBy        *Link        `json:"updatedBy,omitempty"`
 	Version          int          `json:"version,omitempty"`
 	Revision         int          `json:"revision,omitempty"`
 	ContentType      *ContentType `json:"contentType,omitempty"`
 	Space            *Space       `json:"space,omitempty"`
+	Environment      *Link        `json:"environment,omitempty"`
+	AppDefinition    *Link        `json:"appDefinition,omitempty"`
+	Locale           string       `json:"locale,omitempty"`
 	FirstPublishedAt string       `json:"firstPublishedAt,omitempty"`
 	PublishedCounter int          `json:"publishedCounter,omitempty"`
 	PublishedAt      string       `json:"publishedAt,omitempty"`
-	PublishedBy      *Sys         `json:"publishedBy,omitempty"`
+	PublishedBy      *Link        `json:"publishedBy,omitempty"`
 	PublishedVersion int          `json:"publishedVersion,omitempty"`
+	ArchivedAt       string       `json:"archivedAt,omitempty"`
+	ArchivedBy       *Link        `json:"archivedBy,omitempty"`
+	ArchivedVersion  int          `json:"archivedVersion,omitempty"`
+	DeletedAt        string       `json:"deletedAt,omitempty"`
+	Status           string       `json:"status,omitempty"`
+}
+
+// Link model, a reference to another entity, e.g. the user who created an
+// entity
+type Link struct {
+	Sys *Sys `json:"sys,omitempty"`
+}
+
+// NewLink returns a link to the entity of the given type and id, e.g.
+// NewLink("Environment", "master")
+func NewLink(linkType, id string) *Link {
+	return &Link{
+		Sys: &Sys{
+			Type:     "Link",
+			LinkType: linkType,
+			ID:       id,
+		},
+	}
+}
+
+// NewEntryLink returns a link to the entry with the given id
+func NewEntryLink(id string) *Link {
+	return NewLink(LinkTypeEntry, id)
+}
+
+// NewAssetLink returns a link to the asset with the given id
+func NewAssetLink(id string) *Link {
+	return NewLink(LinkTypeAsset, id)
+}
+
+// NewLinks returns links to the entities of the given type and ids, e.g. the
+// value of a field holding an array of references
+func NewLinks(linkType string, ids ...string) []*Link {
+	links := []*Link{}
+	for _, id := range ids {
+		links = append(links, NewLink(linkType, id))
+	}
+
+	return links
+}
+
+// isPublished reports whether the entity has a published version
+func (sys *Sys) isPublished() bool {
+	return sys.PublishedVersion > 0 || sys.PublishedAt != ""
+}
+
+// EntryStatus is the publishing state of an entry or asset, as shown by the
+// status badges of the web app
+type EntryStatus string
+
+const (
+	// EntryStatusDraft entities have never been published, or were
+	// unpublished
+	EntryStatusDraft EntryStatus = "draft"
+
+	// EntryStatusPublished entities are published in their latest version
+	EntryStatusPublished EntryStatus = "published"
+
+	// EntryStatusChanged entities are published, and changed since
+	EntryStatusChanged EntryStatus = "changed"
+
+	// EntryStatusArchived entities are archived
+	EntryStatusArchived EntryStatus = "archived"
+)
+
+// status returns the publishing state of the entity. Publishing bumps the
+// version, so the latest version is published when the published version is
+// the one before it. Entities of the delivery apis have a revision instead of
+// versions, and are published.
+func (sys *Sys) status() EntryStatus {
+	switch {
+	case sys == nil:
+		return EntryStatusDraft
+	case sys.ArchivedAt != "" || sys.ArchivedVersion > 0:
+		return EntryStatusArchived
+	case sys.Version == 0 && sys.Revision > 0:
+		return EntryStatusPublished
+	case !sys.isPublished():
+		return EntryStatusDraft
+	case sys.PublishedVersion > 0 && sys.Version > sys.PublishedVersion+1:
+		return EntryStatusChanged
+	}
+
+	return EntryStatusPublished
+}
+
+// Location model, the value of a Location field
+type Location struct {
+	Latitude  float64 `json:"lat"`
+	Longitude float64 `json:"lon"`
 }
@@ -0,0 +1,114 @@
+package contentful
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSysUnmarshal(t *testing.T) {
+	assert := assert.New(t)
+
+	var sys Sys
+	err := json.Unmarshal([]byte(`{
+		"id": "nyancat",
+		"type": "Entry",
+		"version": 12,
+		"revision": 3,
+		"locale": "en-US",
+		"createdAt": "2019-07-01T10:00:00Z",
+		"createdBy": {"sys": {"type": "Link", "linkType": "User", "id": "user-1"}},
+		"updatedAt": "2019-07-02T10:00:00Z",
+		"updatedBy": {"sys": {"type": "Link", "linkType": "User", "id": "user-2"}},
+		"firstPublishedAt": "2019-07-01T11:00:00Z",
+		"publishedAt": "2019-07-02T11:00:00Z",
+		"publishedBy": {"sys": {"type": "Link", "linkType": "User", "id": "user-2"}},
+		"publishedVersion": 11,
+		"publishedCounter": 2,
+		"archivedAt": "2019-07-03T10:00:00Z",
+		"archivedBy": {"sys": {"type": "Link", "linkType": "User", "id": "user-3"}},
+		"archivedVersion": 12,
+		"environment": {"sys": {"type": "Link", "linkType": "Environment", "id": "staging"}},
+		"space": {"sys": {"type": "Link", "linkType": "Space", "id": "id1"}},
+		"contentType": {"sys": {"type": "Link", "linkType": "ContentType", "id": "cat"}}
+	}`), &sys)
+	assert.Nil(err)
+
+	assert.Equal("nyancat", sys.ID)
+	assert.Equal(3, sys.Revision)
+	assert.Equal("en-US", sys.Locale)
+	assert.Equal("user-1", sys.CreatedBy.Sys.ID)
+	assert.Equal("2019-07-02T10:00:00Z", sys.UpdatedAt)
+	assert.Equal("user-2", sys.UpdatedBy.Sys.ID)
+	assert.Equal("User", sys.PublishedBy.Sys.LinkType)
+	assert.Equal(11, sys.PublishedVersion)
+	assert.Equal(2, sys.PublishedCounter)
+	assert.Equal("2019-07-03T10:00:00Z", sys.ArchivedAt)
+	assert.Equal("user-3", sys.ArchivedBy.Sys.ID)
+	assert.Equal(12, sys.ArchivedVersion)
+	assert.Equal(NewLink("Environment", "staging"), sys.Environment)
+	assert.Equal("id1", sys.Space.Sys.ID)
+	assert.Equal("cat", sys.ContentType.Sys.ID)
+}
+
+func TestLinkMarshal(t *testing.T) {
+	assert := assert.New(t)
+
+	byteArray, err := json.Marshal(NewEntryLink("nyancat"))
+	assert.Nil(err)
+	assert.JSONEq(`{"sys": {"type": "Link", "linkType": "Entry", "id": "nyancat"}}`, string(byteArray))
+
+	byteArray, err = json.Marshal(NewAssetLink("happycat"))
+	assert.Nil(err)
+	assert.JSONEq(`{"sys": {"type": "Link", "linkType": "Asset", "id": "happycat"}}`, string(byteArray))
+
+	entry := &Entry{
+		Fields: map[string]interface{}{
+			"bestFriend": map[string]interface{}{"en-US": NewEntryLink("happycat")},
+			"images":     map[string]interface{}{"en-US": NewLinks("Asset", "nyancat", "happycat")},
+		},
+	}
+
+	byteArray, err = json.Marshal(entry)
+	assert.Nil(err)
+	assert.JSONEq(`{"fields": {
+		"bestFriend": {"en-US": {"sys": {"type": "Link", "linkType": "Entry", "id": "happycat"}}},
+		"images": {"en-US": [
+			{"sys": {"type": "Link", "linkType": "Asset", "id": "nyancat"}},
+			{"sys": {"type": "Link", "linkType": "Asset", "id": "happycat"}}
+		]}
+	}}`, string(byteArray))
+
+	var link Link
+	err = json.Unmarshal([]byte(`{"sys": {"type": "Link", "linkType": "Entry", "id": "happycat"}}`), &link)
+	assert.Nil(err)
+	assert.Equal(NewEntryLink("happycat"), &link)
+}
+
+func TestEntryStatus(t *testing.T) {
+	tests := []struct {
+		name   string
+		sys    *Sys
+		status EntryStatus
+	}{
+		{"no sys", nil, EntryStatusDraft},
+		{"new", &Sys{Version: 1}, EntryStatusDraft},
+		{"draft", &Sys{Version: 4}, EntryStatusDraft},
+		{"published", &Sys{Version: 5, PublishedVersion: 4, PublishedAt: "2019-07-02T11:00:00Z"}, EntryStatusPublished},
+		{"changed", &Sys{Version: 6, PublishedVersion: 4, PublishedAt: "2019-07-02T11:00:00Z"}, EntryStatusChanged},
+		{"unpublished", &Sys{Version: 6, PublishedCounter: 1}, EntryStatusDraft},
+		{"archived", &Sys{Version: 7, PublishedVersion: 4, ArchivedAt: "2019-07-03T10:00:00Z", ArchivedVersion: 6}, EntryStatusArchived},
+		{"delivered", &Sys{Revision: 3}, EntryStatusPublished},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			entry := &Entry{Sys: test.sys}
+			assert.Equal(t, test.status, entry.Status())
+
+			asset := &Asset{Sys: test.sys}
+			assert.Equal(t, test.status, asset.Status())
+		})
+	}
+}
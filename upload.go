@@ -0,0 +1,79 @@
+package contentful
+
+import (
+	"context"
+	"io"
+)
+
+// LinkTypeUpload link type of links to uploads, see NewUploadLink
+const LinkTypeUpload = "Upload"
+
+// UploadsService service, uploads are sent to the client's UploadURL
+type UploadsService service
+
+// Upload model, a file uploaded to be attached to an asset. Uploads expire
+// after 24 hours.
+type Upload struct {
+	Sys *Sys `json:"sys,omitempty"`
+}
+
+// NewUploadLink returns the link to the upload with the given id, set it as
+// the UploadFrom of an asset's file and process the asset to attach the file
+func NewUploadLink(id string) *Link {
+	return NewLink(LinkTypeUpload, id)
+}
+
+// Create uploads the file read from r
+func (service *UploadsService) Create(spaceID string, r io.Reader) (*Upload, error) {
+	return service.create(context.Background(), spaceID, r)
+}
+
+func (service *UploadsService) create(ctx context.Context, spaceID string, r io.Reader) (*Upload, error) {
+	path := service.c.envPath(spaceID, "/uploads")
+	method := "POST"
+
+	req, err := service.c.newHostRequest(service.c.UploadURL, method, path, nil, r)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/octet-stream")
+
+	var upload Upload
+	if err := service.c.do(withContext(req, ctx), &upload); err != nil {
+		return nil, err
+	}
+
+	return &upload, nil
+}
+
+// Get returns a single upload
+func (service *UploadsService) Get(spaceID, uploadID string) (*Upload, error) {
+	path := service.c.envPath(spaceID, "/uploads/%s", uploadID)
+	method := "GET"
+
+	req, err := service.c.newHostRequest(service.c.UploadURL, method, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var upload Upload
+	if err := service.c.do(req, &upload); err != nil {
+		return nil, err
+	}
+
+	return &upload, nil
+}
+
+// Delete the upload
+func (service *UploadsService) Delete(spaceID string, upload *Upload) error {
+	path := service.c.envPath(spaceID, "/uploads/%s", upload.Sys.ID)
+	method := "DELETE"
+
+	req, err := service.c.newHostRequest(service.c.UploadURL, method, path, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return service.c.do(req, nil)
+}
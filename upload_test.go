@@ -0,0 +1,72 @@
+package contentful
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUploadsServiceCreate(t *testing.T) {
+	assert := assert.New(t)
+
+	var uploaded string
+	uploadServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("POST", r.Method)
+		assert.Equal("/spaces/"+spaceID+"/environments/master/uploads", r.URL.Path)
+		assert.Equal("application/octet-stream", r.Header.Get("Content-Type"))
+
+		body, _ := ioutil.ReadAll(r.Body)
+		uploaded = string(body)
+
+		fmt.Fprintln(w, `{"sys": {"id": "upload-id", "type": "Upload"}}`)
+	}))
+	defer uploadServer.Close()
+
+	apiRequests := []string{}
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		apiRequests = append(apiRequests, r.Method+" "+r.URL.Path)
+		fmt.Fprintln(w, `{"sys": {"id": "nyancat", "version": 2}}`)
+	}))
+	defer apiServer.Close()
+
+	cma = NewCMA(CMAToken, WithBaseURL(apiServer.URL), WithUploadURL(uploadServer.URL))
+
+	upload, err := cma.Uploads.Create(spaceID, strings.NewReader("nyan"))
+	assert.Nil(err)
+	assert.Equal("upload-id", upload.Sys.ID)
+	assert.Equal("nyan", uploaded)
+	assert.Empty(apiRequests)
+
+	entry := &Entry{Sys: &Sys{ID: "nyancat", Version: 1}, Fields: map[string]interface{}{}}
+	assert.Nil(cma.Entries.Update(context.Background(), spaceID, entry))
+	assert.Equal([]string{"PUT /spaces/" + spaceID + "/environments/master/entries/nyancat"}, apiRequests)
+}
+
+func TestUploadsServiceDelete(t *testing.T) {
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("DELETE", r.Method)
+		assert.Equal("/spaces/"+spaceID+"/environments/master/uploads/upload-id", r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	cma = NewCMA(CMAToken, WithUploadURL(server.URL))
+
+	err := cma.Uploads.Delete(spaceID, &Upload{Sys: &Sys{ID: "upload-id"}})
+	assert.Nil(err)
+}
+
+func TestNewUploadLink(t *testing.T) {
+	link := NewUploadLink("upload-id")
+	assert.Equal(t, &Link{Sys: &Sys{Type: "Link", LinkType: "Upload", ID: "upload-id"}}, link)
+}
@@ -0,0 +1,95 @@
+package contentful
+
+import (
+	"context"
+	"net/url"
+	"time"
+)
+
+// UsageService service
+type UsageService service
+
+// usageFeature is the alpha feature the usage endpoints are enabled by
+const usageFeature = "usage-insights"
+
+// PeriodicUsage model, the usage of a metric, e.g. "cma" requests, over a
+// period of an organization or a space. UsagePerDay is keyed by date, e.g.
+// "2021-05-01".
+type PeriodicUsage struct {
+	Sys           *Sys           `json:"sys"`
+	Metric        string         `json:"metric"`
+	UnitOfMeasure string         `json:"unitOfMeasure"`
+	DateRange     *UsagePeriod   `json:"dateRange"`
+	TotalUsage    int            `json:"totalUsage"`
+	UsagePerDay   map[string]int `json:"usagePerDay"`
+}
+
+// UsagePeriod model, dates are formatted as "2006-01-02"
+type UsagePeriod struct {
+	StartAt string `json:"startAt"`
+	EndAt   string `json:"endAt"`
+}
+
+// UsageResult model, the usage per metric of an organization
+type UsageResult struct {
+	Total int              `json:"total"`
+	Items []*PeriodicUsage `json:"items"`
+}
+
+// Usage returns the usage of the given metric, nil when there is none
+func (result *UsageResult) Usage(metric string) *PeriodicUsage {
+	for _, usage := range result.Items {
+		if usage.Metric == metric {
+			return usage
+		}
+	}
+
+	return nil
+}
+
+// Spaces fetches the first page of the periodic usages of the spaces of the
+// organization in the current period, further pages are fetched with Next
+func (service *UsageService) Spaces(ctx context.Context, organizationID string) (*Collection, error) {
+	path := pathf("/organizations/%s/space_periodic_usages", organizationID)
+	method := "GET"
+
+	req, err := service.c.newRequest(method, path, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("X-Contentful-Enable-Alpha-Feature", usageFeature)
+
+	col := NewCollection(&CollectionOptions{})
+	col.Query.order = []string{}
+	col.c = service.c
+	col.req = withContext(req, ctx)
+
+	return col.Next()
+}
+
+// APIRequests returns the api requests made by the organization per day
+// between the given dates, per api, e.g. the "cma" metric
+func (service *UsageService) APIRequests(ctx context.Context, organizationID string, from, to time.Time) (*UsageResult, error) {
+	path := pathf("/organizations/%s/organization_periodic_usages", organizationID)
+	method := "GET"
+
+	query := url.Values{}
+	query.Set("metric[in]", "cda,cma,cpa,gql")
+	query.Set("dateRange.startAt", from.Format("2006-01-02"))
+	query.Set("dateRange.endAt", to.Format("2006-01-02"))
+
+	req, err := service.c.newRequest(method, path, query, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("X-Contentful-Enable-Alpha-Feature", usageFeature)
+
+	var result UsageResult
+	if err := service.c.do(withContext(req, ctx), &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,73 @@
+package contentful
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsageServiceAPIRequests(t *testing.T) {
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("GET", r.Method)
+		assert.Equal("/organizations/org-id/organization_periodic_usages", r.URL.Path)
+		assert.Equal("usage-insights", r.Header.Get("X-Contentful-Enable-Alpha-Feature"))
+		assert.Equal("2021-05-01", r.URL.Query().Get("dateRange.startAt"))
+		assert.Equal("2021-05-03", r.URL.Query().Get("dateRange.endAt"))
+
+		fmt.Fprintln(w, readTestData("organization_periodic_usages.json"))
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	cma = NewCMA(CMAToken, WithBaseURL(server.URL))
+
+	from := time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2021, 5, 3, 0, 0, 0, 0, time.UTC)
+
+	result, err := cma.Usage.APIRequests(context.Background(), "org-id", from, to)
+	assert.Nil(err)
+	assert.Equal(2, result.Total)
+
+	usage := result.Usage("cma")
+	assert.Equal("apiRequests", usage.UnitOfMeasure)
+	assert.Equal(60, usage.TotalUsage)
+	assert.Equal(&UsagePeriod{StartAt: "2021-05-01", EndAt: "2021-05-03"}, usage.DateRange)
+	assert.Equal(20, usage.UsagePerDay["2021-05-02"])
+
+	assert.Equal(3000, result.Usage("cda").TotalUsage)
+	assert.Nil(result.Usage("gql"))
+}
+
+func TestUsageServiceSpaces(t *testing.T) {
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("/organizations/org-id/space_periodic_usages", r.URL.Path)
+		assert.Equal("", r.URL.Query().Get("order"))
+
+		fmt.Fprintln(w, readTestData("space_periodic_usages.json"))
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	cma = NewCMA(CMAToken, WithBaseURL(server.URL))
+
+	col, err := cma.Usage.Spaces(context.Background(), "org-id")
+	assert.Nil(err)
+	assert.False(col.HasMore())
+
+	usages := col.ToPeriodicUsage()
+	assert.Equal(1, len(usages))
+	assert.Equal("cma", usages[0].Metric)
+	assert.Equal(42, usages[0].TotalUsage)
+	assert.Equal("cfexampleapi", usages[0].Sys.Space.Sys.ID)
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"strconv"
 )
 
@@ -12,19 +13,39 @@ type WebhooksService service
 
 // Webhook model
 type Webhook struct {
-	Sys               *Sys             `json:"sys,omitempty"`
-	Name              string           `json:"name,omitempty"`
-	URL               string           `json:"url,omitempty"`
-	Topics            []string         `json:"topics,omitempty"`
-	HTTPBasicUsername string           `json:"httpBasicUsername,omitempty"`
-	HTTPBasicPassword string           `json:"httpBasicPassword,omitempty"`
-	Headers           []*WebhookHeader `json:"headers,omitempty"`
+	Sys               *Sys                     `json:"sys,omitempty"`
+	Name              string                   `json:"name,omitempty"`
+	URL               string                   `json:"url,omitempty"`
+	Topics            []string                 `json:"topics,omitempty"`
+	HTTPBasicUsername string                   `json:"httpBasicUsername,omitempty"`
+	HTTPBasicPassword string                   `json:"httpBasicPassword,omitempty"`
+	Headers           []*WebhookHeader         `json:"headers,omitempty"`
+	Filters           []map[string]interface{} `json:"filters,omitempty"`
 }
 
-// WebhookHeader model
+// WebhookHeader model. Values of secret headers are not returned by the api,
+// leaving the value of a secret header empty keeps its stored value on update.
 type WebhookHeader struct {
-	Key   string `json:"key"`
-	Value string `json:"value"`
+	Key    string `json:"key"`
+	Value  string `json:"value"`
+	Secret bool   `json:"secret,omitempty"`
+}
+
+// MarshalJSON for custom json marshaling
+func (header *WebhookHeader) MarshalJSON() ([]byte, error) {
+	if header.Secret && header.Value == "" {
+		return json.Marshal(&struct {
+			Key    string `json:"key"`
+			Secret bool   `json:"secret"`
+		}{
+			Key:    header.Key,
+			Secret: true,
+		})
+	}
+
+	type Alias WebhookHeader
+
+	return json.Marshal((*Alias)(header))
 }
 
 // GetVersion returns entity version
@@ -39,7 +60,7 @@ func (webhook *Webhook) GetVersion() int {
 
 // List returns webhooks collection
 func (service *WebhooksService) List(spaceID string) *Collection {
-	path := fmt.Sprintf("/spaces/%s/webhook_definitions", spaceID)
+	path := spacePath(spaceID, "/webhook_definitions")
 	method := "GET"
 
 	req, err := service.c.newRequest(method, path, nil, nil)
@@ -56,7 +77,7 @@ func (service *WebhooksService) List(spaceID string) *Collection {
 
 // Get returns a single webhook entity
 func (service *WebhooksService) Get(spaceID, webhookID string) (*Webhook, error) {
-	path := fmt.Sprintf("/spaces/%s/webhook_definitions/%s", spaceID, webhookID)
+	path := spacePath(spaceID, "/webhook_definitions/%s", webhookID)
 	method := "GET"
 
 	req, err := service.c.newRequest(method, path, nil, nil)
@@ -74,19 +95,29 @@ func (service *WebhooksService) Get(spaceID, webhookID string) (*Webhook, error)
 
 // Upsert updates or creates a new entity
 func (service *WebhooksService) Upsert(spaceID string, webhook *Webhook) error {
+	if webhook.Sys != nil && webhook.Sys.CreatedAt != "" {
+		return service.Update(spaceID, webhook)
+	}
+
+	return service.Create(spaceID, webhook)
+}
+
+// Create creates a new webhook. When `webhook.Sys.ID` is set, the webhook is
+// created with that id.
+func (service *WebhooksService) Create(spaceID string, webhook *Webhook) error {
 	bytesArray, err := json.Marshal(webhook)
 	if err != nil {
 		return err
 	}
 
-	var path string
+	var path apiPath
 	var method string
 
-	if webhook.Sys != nil && webhook.Sys.CreatedAt != "" {
-		path = fmt.Sprintf("/spaces/%s/webhook_definitions/%s", spaceID, webhook.Sys.ID)
+	if webhook.Sys != nil && webhook.Sys.ID != "" {
+		path = spacePath(spaceID, "/webhook_definitions/%s", webhook.Sys.ID)
 		method = "PUT"
 	} else {
-		path = fmt.Sprintf("/spaces/%s/webhook_definitions", spaceID)
+		path = spacePath(spaceID, "/webhook_definitions")
 		method = "POST"
 	}
 
@@ -95,14 +126,59 @@ func (service *WebhooksService) Upsert(spaceID string, webhook *Webhook) error {
 		return err
 	}
 
+	return service.do(req, webhook)
+}
+
+// Update updates an existing webhook
+func (service *WebhooksService) Update(spaceID string, webhook *Webhook) error {
+	if webhook.Sys == nil || webhook.Sys.ID == "" {
+		return fmt.Errorf("updating a webhook requires a webhook id")
+	}
+
+	bytesArray, err := json.Marshal(webhook)
+	if err != nil {
+		return err
+	}
+
+	path := spacePath(spaceID, "/webhook_definitions/%s", webhook.Sys.ID)
+	method := "PUT"
+
+	req, err := service.c.newRequest(method, path, nil, bytes.NewReader(bytesArray))
+	if err != nil {
+		return err
+	}
+
 	req.Header.Set("X-Contentful-Version", strconv.Itoa(webhook.GetVersion()))
 
-	return service.c.do(req, webhook)
+	return service.do(req, webhook)
+}
+
+// do makes the request and keeps the values of the secret headers, which
+// the api leaves out of the response
+func (service *WebhooksService) do(req *http.Request, webhook *Webhook) error {
+	secrets := map[string]string{}
+	for _, header := range webhook.Headers {
+		if header.Secret {
+			secrets[header.Key] = header.Value
+		}
+	}
+
+	if err := service.c.do(req, webhook); err != nil {
+		return err
+	}
+
+	for _, header := range webhook.Headers {
+		if header.Secret && header.Value == "" {
+			header.Value = secrets[header.Key]
+		}
+	}
+
+	return nil
 }
 
 // Delete the webhook
 func (service *WebhooksService) Delete(spaceID string, webhook *Webhook) error {
-	path := fmt.Sprintf("/spaces/%s/webhook_definitions/%s", spaceID, webhook.Sys.ID)
+	path := spacePath(spaceID, "/webhook_definitions/%s", webhook.Sys.ID)
 	method := "DELETE"
 
 	req, err := service.c.newRequest(method, path, nil, nil)
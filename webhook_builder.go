@@ -0,0 +1,225 @@
+package contentful
+
+import (
+	"fmt"
+	"strings"
+)
+
+// webhookTopicEntityTypes are the entity types webhooks can subscribe to
+var webhookTopicEntityTypes = map[string]bool{
+	"*":               true,
+	"Entry":           true,
+	"Asset":           true,
+	"ContentType":     true,
+	"Task":            true,
+	"Comment":         true,
+	"Release":         true,
+	"ReleaseAction":   true,
+	"BulkAction":      true,
+	"ScheduledAction": true,
+}
+
+// webhookTopicActions are the actions webhooks can subscribe to
+var webhookTopicActions = map[string]bool{
+	"*":         true,
+	"create":    true,
+	"save":      true,
+	"auto_save": true,
+	"archive":   true,
+	"unarchive": true,
+	"publish":   true,
+	"unpublish": true,
+	"delete":    true,
+	"execute":   true,
+}
+
+// WebhookBuilder builds webhooks topic by topic, e.g.
+//
+//	webhook, err := NewWebhookBuilder("deploy", "https://example.com/deploy").
+//		OnEntryPublish().
+//		OnEntryUnpublish().
+//		ForEnvironment("master").
+//		Build()
+type WebhookBuilder struct {
+	webhook *Webhook
+	topics  map[string]bool
+}
+
+// NewWebhookBuilder returns a builder for a webhook with the given name and url
+func NewWebhookBuilder(name, url string) *WebhookBuilder {
+	return &WebhookBuilder{
+		webhook: &Webhook{
+			Name:    name,
+			URL:     url,
+			Topics:  []string{},
+			Headers: []*WebhookHeader{},
+		},
+		topics: map[string]bool{},
+	}
+}
+
+// On subscribes the webhook to the given entity type and action, e.g.
+// On("Entry", "publish"). Use "*" as a wildcard for either of them.
+func (b *WebhookBuilder) On(entityType, action string) *WebhookBuilder {
+	topic := entityType + "." + action
+	if !b.topics[topic] {
+		b.topics[topic] = true
+		b.webhook.Topics = append(b.webhook.Topics, topic)
+	}
+
+	return b
+}
+
+// OnAll subscribes the webhook to every topic
+func (b *WebhookBuilder) OnAll() *WebhookBuilder {
+	return b.On("*", "*")
+}
+
+// OnEntryCreate subscribes the webhook to entry creation
+func (b *WebhookBuilder) OnEntryCreate() *WebhookBuilder {
+	return b.On("Entry", "create")
+}
+
+// OnEntrySave subscribes the webhook to entry saves
+func (b *WebhookBuilder) OnEntrySave() *WebhookBuilder {
+	return b.On("Entry", "save")
+}
+
+// OnEntryPublish subscribes the webhook to entry publishing
+func (b *WebhookBuilder) OnEntryPublish() *WebhookBuilder {
+	return b.On("Entry", "publish")
+}
+
+// OnEntryUnpublish subscribes the webhook to entry unpublishing
+func (b *WebhookBuilder) OnEntryUnpublish() *WebhookBuilder {
+	return b.On("Entry", "unpublish")
+}
+
+// OnEntryDelete subscribes the webhook to entry deletion
+func (b *WebhookBuilder) OnEntryDelete() *WebhookBuilder {
+	return b.On("Entry", "delete")
+}
+
+// OnAssetCreate subscribes the webhook to asset creation
+func (b *WebhookBuilder) OnAssetCreate() *WebhookBuilder {
+	return b.On("Asset", "create")
+}
+
+// OnAssetPublish subscribes the webhook to asset publishing
+func (b *WebhookBuilder) OnAssetPublish() *WebhookBuilder {
+	return b.On("Asset", "publish")
+}
+
+// OnAssetUnpublish subscribes the webhook to asset unpublishing
+func (b *WebhookBuilder) OnAssetUnpublish() *WebhookBuilder {
+	return b.On("Asset", "unpublish")
+}
+
+// OnAssetDelete subscribes the webhook to asset deletion
+func (b *WebhookBuilder) OnAssetDelete() *WebhookBuilder {
+	return b.On("Asset", "delete")
+}
+
+// OnContentTypePublish subscribes the webhook to content type publishing
+func (b *WebhookBuilder) OnContentTypePublish() *WebhookBuilder {
+	return b.On("ContentType", "publish")
+}
+
+// OnContentTypeDelete subscribes the webhook to content type deletion
+func (b *WebhookBuilder) OnContentTypeDelete() *WebhookBuilder {
+	return b.On("ContentType", "delete")
+}
+
+// ForEnvironment limits the webhook to events of the given environment
+func (b *WebhookBuilder) ForEnvironment(environmentID string) *WebhookBuilder {
+	return b.withFilter("equals", "sys.environment.sys.id", environmentID)
+}
+
+// ForContentType limits the webhook to entries of the given content type
+func (b *WebhookBuilder) ForContentType(contentTypeID string) *WebhookBuilder {
+	return b.withFilter("equals", "sys.contentType.sys.id", contentTypeID)
+}
+
+// WithHeader adds a header to the webhook requests
+func (b *WebhookBuilder) WithHeader(key, value string) *WebhookBuilder {
+	b.webhook.Headers = append(b.webhook.Headers, &WebhookHeader{
+		Key:   key,
+		Value: value,
+	})
+
+	return b
+}
+
+// WithSecretHeader adds a header whose value is not returned by the api
+func (b *WebhookBuilder) WithSecretHeader(key, value string) *WebhookBuilder {
+	b.webhook.Headers = append(b.webhook.Headers, &WebhookHeader{
+		Key:    key,
+		Value:  value,
+		Secret: true,
+	})
+
+	return b
+}
+
+// WithBasicAuth sets the basic auth credentials of the webhook requests
+func (b *WebhookBuilder) WithBasicAuth(username, password string) *WebhookBuilder {
+	b.webhook.HTTPBasicUsername = username
+	b.webhook.HTTPBasicPassword = password
+
+	return b
+}
+
+// Build returns the webhook, subscribed to every topic when no topic was
+// given. It fails when the webhook has no name or url, or a topic has an
+// unknown entity type or action.
+func (b *WebhookBuilder) Build() (*Webhook, error) {
+	if b.webhook.Name == "" {
+		return nil, fmt.Errorf("webhook has no name")
+	}
+
+	if b.webhook.URL == "" {
+		return nil, fmt.Errorf("webhook %q has no url", b.webhook.Name)
+	}
+
+	for _, topic := range b.webhook.Topics {
+		entityType, action, _ := strings.Cut(topic, ".")
+		if !webhookTopicEntityTypes[entityType] {
+			return nil, fmt.Errorf("webhook topic %q has unknown entity type %q", topic, entityType)
+		}
+
+		if !webhookTopicActions[action] {
+			return nil, fmt.Errorf("webhook topic %q has unknown action %q", topic, action)
+		}
+	}
+
+	webhook := *b.webhook
+
+	webhook.Topics = append([]string{}, b.webhook.Topics...)
+	if len(webhook.Topics) == 0 {
+		webhook.Topics = []string{"*.*"}
+	}
+
+	webhook.Headers = make([]*WebhookHeader, 0, len(b.webhook.Headers))
+	for _, header := range b.webhook.Headers {
+		header := *header
+		webhook.Headers = append(webhook.Headers, &header)
+	}
+
+	webhook.Filters = append([]map[string]interface{}{}, b.webhook.Filters...)
+	if len(webhook.Filters) == 0 {
+		webhook.Filters = nil
+	}
+
+	return &webhook, nil
+}
+
+func (b *WebhookBuilder) withFilter(operator, doc string, value interface{}) *WebhookBuilder {
+	b.webhook.Filters = append(b.webhook.Filters, map[string]interface{}{
+		operator: []interface{}{
+			map[string]interface{}{"doc": doc},
+			value,
+		},
+	})
+
+	return b
+}
@@ -0,0 +1,74 @@
+package contentful
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookBuilder(t *testing.T) {
+	assert := assert.New(t)
+
+	builder := NewWebhookBuilder("deploy", "https://www.example.com/deploy").
+		OnEntryPublish().
+		OnEntryUnpublish().
+		OnAssetDelete().
+		OnEntryPublish().
+		ForEnvironment("master").
+		WithHeader("header1", "header1-value").
+		WithBasicAuth("username", "password")
+
+	webhook, err := builder.Build()
+	assert.Nil(err)
+	assert.Equal("deploy", webhook.Name)
+	assert.Equal("https://www.example.com/deploy", webhook.URL)
+	assert.Equal([]string{"Entry.publish", "Entry.unpublish", "Asset.delete"}, webhook.Topics)
+	assert.Equal("username", webhook.HTTPBasicUsername)
+	assert.Equal("password", webhook.HTTPBasicPassword)
+	assert.Equal(1, len(webhook.Headers))
+	assert.Equal("header1", webhook.Headers[0].Key)
+
+	filters, err := json.Marshal(webhook.Filters)
+	assert.Nil(err)
+	assert.JSONEq(`[{"equals": [{"doc": "sys.environment.sys.id"}, "master"]}]`, string(filters))
+
+	// built webhooks do not share their headers with the builder
+	webhook.Headers[0].Value = "changed"
+	rebuilt, err := builder.Build()
+	assert.Nil(err)
+	assert.Equal("header1-value", rebuilt.Headers[0].Value)
+}
+
+func TestWebhookBuilderDefaults(t *testing.T) {
+	assert := assert.New(t)
+
+	webhook, err := NewWebhookBuilder("all", "https://www.example.com/all").Build()
+	assert.Nil(err)
+	assert.Equal([]string{"*.*"}, webhook.Topics)
+	assert.Nil(webhook.Filters)
+
+	byteArray, err := json.Marshal(webhook)
+	assert.Nil(err)
+	assert.NotContains(string(byteArray), "filters")
+}
+
+func TestWebhookBuilderValidation(t *testing.T) {
+	var err error
+	assert := assert.New(t)
+
+	_, err = NewWebhookBuilder("", "https://www.example.com/deploy").Build()
+	assert.EqualError(err, "webhook has no name")
+
+	_, err = NewWebhookBuilder("deploy", "").Build()
+	assert.EqualError(err, `webhook "deploy" has no url`)
+
+	_, err = NewWebhookBuilder("deploy", "https://www.example.com/deploy").On("Entri", "publish").Build()
+	assert.EqualError(err, `webhook topic "Entri.publish" has unknown entity type "Entri"`)
+
+	_, err = NewWebhookBuilder("deploy", "https://www.example.com/deploy").On("Entry", "published").Build()
+	assert.EqualError(err, `webhook topic "Entry.published" has unknown action "published"`)
+
+	_, err = NewWebhookBuilder("deploy", "https://www.example.com/deploy").On("*", "publish").On("Asset", "*").Build()
+	assert.Nil(err)
+}
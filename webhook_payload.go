@@ -0,0 +1,60 @@
+package contentful
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// HeaderWebhookTopic holds the topic of a webhook request, e.g.
+// ContentManagement.Entry.publish
+const HeaderWebhookTopic = "X-Contentful-Topic"
+
+// WebhookEvent model, the payload of a webhook request. Entity is an
+// *Entry, *Asset or *ContentType depending on the entity type of the topic,
+// and a map[string]interface{} for other entity types. Payloads of deletions
+// hold the sys of the deleted entity only.
+type WebhookEvent struct {
+	Topic      string
+	EntityType string
+	Action     string
+	Entity     interface{}
+}
+
+// ParseWebhookPayload reads the topic and payload of a webhook request
+func ParseWebhookPayload(r *http.Request) (*WebhookEvent, error) {
+	topic := r.Header.Get(HeaderWebhookTopic)
+
+	segments := strings.Split(topic, ".")
+	if len(segments) != 3 {
+		return nil, fmt.Errorf("webhook topic %q is not valid", topic)
+	}
+
+	event := &WebhookEvent{
+		Topic:      topic,
+		EntityType: segments[1],
+		Action:     segments[2],
+	}
+
+	switch event.EntityType {
+	case "Entry":
+		event.Entity = &Entry{}
+	case "Asset":
+		event.Entity = &Asset{}
+	case "ContentType":
+		event.Entity = &ContentType{}
+	default:
+		event.Entity = &map[string]interface{}{}
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(event.Entity); err != nil {
+		return nil, fmt.Errorf("decoding webhook payload of %s: %w", topic, err)
+	}
+
+	if entity, ok := event.Entity.(*map[string]interface{}); ok {
+		event.Entity = *entity
+	}
+
+	return event, nil
+}
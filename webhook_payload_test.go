@@ -0,0 +1,65 @@
+package contentful
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseWebhookPayloadEntryPublish(t *testing.T) {
+	assert := assert.New(t)
+
+	r := httptest.NewRequest("POST", "/webhooks", strings.NewReader(readTestData("entry_3.json")))
+	r.Header.Set(HeaderWebhookTopic, "ContentManagement.Entry.publish")
+
+	event, err := ParseWebhookPayload(r)
+	assert.Nil(err)
+	assert.Equal("ContentManagement.Entry.publish", event.Topic)
+	assert.Equal("Entry", event.EntityType)
+	assert.Equal("publish", event.Action)
+
+	entry, ok := event.Entity.(*Entry)
+	assert.True(ok)
+	assert.Equal("foocat", entry.Sys.ID)
+	assert.NotEmpty(entry.Fields)
+}
+
+func TestParseWebhookPayloadAssetDelete(t *testing.T) {
+	assert := assert.New(t)
+
+	payload := `{"sys": {"type": "DeletedAsset", "id": "nyancat", "revision": 2}}`
+	r := httptest.NewRequest("POST", "/webhooks", strings.NewReader(payload))
+	r.Header.Set(HeaderWebhookTopic, "ContentManagement.Asset.delete")
+
+	event, err := ParseWebhookPayload(r)
+	assert.Nil(err)
+	assert.Equal("Asset", event.EntityType)
+	assert.Equal("delete", event.Action)
+
+	asset, ok := event.Entity.(*Asset)
+	assert.True(ok)
+	assert.Equal("nyancat", asset.Sys.ID)
+	assert.Equal("DeletedAsset", asset.Sys.Type)
+}
+
+func TestParseWebhookPayloadInvalid(t *testing.T) {
+	assert := assert.New(t)
+
+	r := httptest.NewRequest("POST", "/webhooks", strings.NewReader(`{}`))
+	_, err := ParseWebhookPayload(r)
+	assert.NotNil(err)
+
+	r = httptest.NewRequest("POST", "/webhooks", strings.NewReader(`not json`))
+	r.Header.Set(HeaderWebhookTopic, "ContentManagement.Entry.publish")
+	_, err = ParseWebhookPayload(r)
+	assert.NotNil(err)
+
+	// other entity types are decoded into maps
+	r = httptest.NewRequest("POST", "/webhooks", strings.NewReader(`{"sys": {"id": "task"}}`))
+	r.Header.Set(HeaderWebhookTopic, "ContentManagement.Task.create")
+	event, err := ParseWebhookPayload(r)
+	assert.Nil(err)
+	assert.Equal(map[string]interface{}{"sys": map[string]interface{}{"id": "task"}}, event.Entity)
+}
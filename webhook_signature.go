@@ -0,0 +1,108 @@
+package contentful
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+const (
+	// HeaderWebhookSignature holds the signature of a signed webhook request
+	HeaderWebhookSignature = "X-Contentful-Signature"
+
+	// HeaderWebhookSignedHeaders lists the headers covered by the signature
+	HeaderWebhookSignedHeaders = "X-Contentful-Signed-Headers"
+
+	// HeaderWebhookTimestamp holds the time the webhook request was signed
+	// at, in milliseconds since the epoch
+	HeaderWebhookTimestamp = "X-Contentful-Timestamp"
+)
+
+// NewWebhookSigningSecret returns a random secret to sign webhook requests
+// with, see SetSigningSecret
+func NewWebhookSigningSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(secret), nil
+}
+
+// SetSigningSecret sets the secret the webhook requests of the space are
+// signed with, the api does not return it afterwards. Receivers check the
+// signature with VerifyWebhookSignature.
+func (service *WebhooksService) SetSigningSecret(spaceID, secret string) error {
+	bytesArray, err := json.Marshal(map[string]string{"value": secret})
+	if err != nil {
+		return err
+	}
+
+	path := spacePath(spaceID, "/webhook_settings/signing_secret")
+	method := "PUT"
+
+	req, err := service.c.newRequest(method, path, nil, bytes.NewReader(bytesArray))
+	if err != nil {
+		return err
+	}
+
+	return service.c.do(req, nil)
+}
+
+// VerifyWebhookSignature reports whether the webhook request is signed with
+// the given secret. The body of the request is read and replaced, so the
+// handler can read it again. The timestamp of the request is signed too,
+// check it to reject replayed requests. It returns an error when the request
+// is not signed at all.
+func VerifyWebhookSignature(secret string, r *http.Request) (bool, error) {
+	signature := r.Header.Get(HeaderWebhookSignature)
+	signedHeaders := r.Header.Get(HeaderWebhookSignedHeaders)
+	if signature == "" || signedHeaders == "" {
+		return false, fmt.Errorf("webhook request is not signed")
+	}
+
+	body := []byte{}
+	if r.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(r.Body)
+		r.Body.Close()
+		if err != nil {
+			return false, err
+		}
+
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	expected := webhookSignature(secret, r, strings.Split(signedHeaders, ","), body)
+
+	return hmac.Equal([]byte(expected), []byte(strings.ToLower(signature))), nil
+}
+
+// webhookSignature returns the signature of the canonical request: the
+// method, the path with the query, the signed headers and the body
+func webhookSignature(secret string, r *http.Request, signedHeaders []string, body []byte) string {
+	headers := make([]string, len(signedHeaders))
+	for i, key := range signedHeaders {
+		key = strings.ToLower(strings.TrimSpace(key))
+		headers[i] = key + ":" + strings.TrimSpace(r.Header.Get(key))
+	}
+
+	canonical := strings.Join([]string{
+		strings.ToUpper(r.Method),
+		r.URL.RequestURI(),
+		strings.Join(headers, ";"),
+		string(body),
+	}, "\n")
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(canonical))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,80 @@
+package contentful
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func signedWebhookRequest(signature string) *http.Request {
+	r := httptest.NewRequest("POST", "/webhooks?space=cfexampleapi", strings.NewReader(`{"sys":{"id":"nyancat"}}`))
+	r.Header.Set("X-Contentful-Topic", "ContentManagement.Entry.publish")
+	r.Header.Set(HeaderWebhookTimestamp, "1621263780000")
+	r.Header.Set(HeaderWebhookSignedHeaders, "x-contentful-timestamp,x-contentful-topic")
+	r.Header.Set(HeaderWebhookSignature, signature)
+
+	return r
+}
+
+func TestVerifyWebhookSignature(t *testing.T) {
+	assert := assert.New(t)
+
+	signature := "91a014740a655f8dad9cd98eb5f81b37efbf8d0b28cfae5dc78dc2562d92f8c1"
+
+	r := signedWebhookRequest(signature)
+	ok, err := VerifyWebhookSignature("my-signing-secret", r)
+	assert.Nil(err)
+	assert.True(ok)
+
+	// the body can be read again
+	body, err := ioutil.ReadAll(r.Body)
+	assert.Nil(err)
+	assert.Equal(`{"sys":{"id":"nyancat"}}`, string(body))
+
+	ok, err = VerifyWebhookSignature("another-secret", signedWebhookRequest(signature))
+	assert.Nil(err)
+	assert.False(ok)
+
+	// a tampered signed header fails
+	r = signedWebhookRequest(signature)
+	r.Header.Set(HeaderWebhookTimestamp, "1621263790000")
+	ok, err = VerifyWebhookSignature("my-signing-secret", r)
+	assert.Nil(err)
+	assert.False(ok)
+
+	r = signedWebhookRequest("")
+	_, err = VerifyWebhookSignature("my-signing-secret", r)
+	assert.NotNil(err)
+}
+
+func TestWebhooksServiceSetSigningSecret(t *testing.T) {
+	assert := assert.New(t)
+
+	secret, err := NewWebhookSigningSecret()
+	assert.Nil(err)
+	assert.Len(secret, 64)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("PUT", r.Method)
+		assert.Equal("/spaces/"+spaceID+"/webhook_settings/signing_secret", r.URL.Path)
+
+		var payload map[string]string
+		assert.Nil(json.NewDecoder(r.Body).Decode(&payload))
+		assert.Equal(secret, payload["value"])
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"redactedValue": "` + secret[60:] + `"}`))
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	cma = NewCMA(CMAToken, WithBaseURL(server.URL))
+
+	assert.Nil(cma.Webhooks.SetSigningSecret(spaceID, secret))
+}
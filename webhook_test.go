@@ -161,6 +161,36 @@ func TestWebhookSaveForUpdate(t *testing.T) {
 	assert.Equal("updated-username", webhook.HTTPBasicUsername)
 }
 
+func TestWebhookCreateWithID(t *testing.T) {
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal("PUT", r.Method)
+		assert.Equal("/spaces/"+spaceID+"/webhook_definitions/deploy", r.RequestURI)
+		assert.Empty(r.Header.Get("X-Contentful-Version"))
+		checkHeaders(r, assert)
+
+		w.WriteHeader(201)
+		fmt.Fprintln(w, `{"sys": {"id": "deploy", "version": 1, "createdAt": "2017-03-20T17:52:38Z"}, "name": "deploy"}`)
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	// the version of a webhook copied from another space is not sent along
+	webhook, err := NewWebhookBuilder("deploy", "https://example.com/deploy").Build()
+	assert.Nil(err)
+	webhook.Sys = &Sys{ID: "deploy", Version: 3}
+
+	err = cma.Webhooks.Upsert(spaceID, webhook)
+	assert.Nil(err)
+	assert.Equal(1, webhook.Sys.Version)
+	assert.Equal("2017-03-20T17:52:38Z", webhook.Sys.CreatedAt)
+}
+
 func TestWebhookDelete(t *testing.T) {
 	var err error
 	assert := assert.New(t)
@@ -188,3 +218,76 @@ func TestWebhookDelete(t *testing.T) {
 	err = cma.Webhooks.Delete(spaceID, webhook)
 	assert.Nil(err)
 }
+
+func TestWebhookSecretHeaders(t *testing.T) {
+	var err error
+	assert := assert.New(t)
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(r.Method, "PUT")
+		assert.Equal(r.RequestURI, "/spaces/"+spaceID+"/webhook_definitions/7fstd9fZ9T2p3kwD49FxhI")
+
+		var payload map[string]interface{}
+		err := json.NewDecoder(r.Body).Decode(&payload)
+		assert.Nil(err)
+
+		headers := payload["headers"].([]interface{})
+		assert.Equal(2, len(headers))
+		header1 := headers[0].(map[string]interface{})
+		header2 := headers[1].(map[string]interface{})
+
+		assert.Equal("header1", header1["key"])
+		assert.Equal(true, header1["secret"])
+		assert.Equal("secret-value", header1["value"])
+
+		assert.Equal("header2", header2["key"])
+		assert.Equal(true, header2["secret"])
+		_, ok := header2["value"]
+		assert.False(ok)
+
+		w.WriteHeader(200)
+		fmt.Fprintln(w, `{
+			"name": "webhook-name",
+			"headers": [
+				{"key": "header1", "secret": true},
+				{"key": "header2", "secret": true}
+			],
+			"sys": {"id": "7fstd9fZ9T2p3kwD49FxhI", "version": 1, "createdAt": "2017-03-20T17:52:38Z"}
+		}`)
+	})
+
+	// test server
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// cma client
+	cma = NewCMA(CMAToken)
+	cma.BaseURL = server.URL
+
+	webhook := &Webhook{
+		Sys: &Sys{
+			ID:        "7fstd9fZ9T2p3kwD49FxhI",
+			Version:   1,
+			CreatedAt: "2017-03-20T17:52:38Z",
+		},
+		Name: "webhook-name",
+		Headers: []*WebhookHeader{
+			&WebhookHeader{
+				Key:    "header1",
+				Value:  "secret-value",
+				Secret: true,
+			},
+			&WebhookHeader{
+				Key:    "header2",
+				Secret: true,
+			},
+		},
+	}
+
+	err = cma.Webhooks.Upsert(spaceID, webhook)
+	assert.Nil(err)
+	assert.Equal(2, len(webhook.Headers))
+	assert.Equal("secret-value", webhook.Headers[0].Value)
+	assert.True(webhook.Headers[0].Secret)
+	assert.Equal("", webhook.Headers[1].Value)
+}